@@ -0,0 +1,17 @@
+# Find the subnet of an address handed out by a hypervisor, along with every block above it.
+data "netdot_containing_ipblock" "vm" {
+  address      = "184.171.0.37"
+  walk_to_root = true
+}
+
+output "vm_subnet" {
+  value = "${data.netdot_containing_ipblock.vm.ipblock.address}/${data.netdot_containing_ipblock.vm.ipblock.prefix}"
+}
+
+output "vm_vlan" {
+  value = data.netdot_containing_ipblock.vm.ipblock.vlan
+}
+
+output "vm_containers" {
+  value = [for ancestor in data.netdot_containing_ipblock.vm.ancestors : "${ancestor.address}/${ancestor.prefix}"]
+}
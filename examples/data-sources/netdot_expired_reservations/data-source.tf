@@ -0,0 +1,19 @@
+# Reserve an address for a CI run, it may be reclaimed after a day.
+resource "netdot_ipblock" "ci_runner" {
+  parent_id      = 2711826724
+  description    = "ci runner"
+  reserved_until = timeadd(plantimestamp(), "24h")
+
+  lifecycle {
+    ignore_changes = [reserved_until]
+  }
+}
+
+# List everything that ran out, netdot_reservation_reaper releases them.
+data "netdot_expired_reservations" "lab" {
+  parent_id = 2711826724
+}
+
+output "expired" {
+  value = [for r in data.netdot_expired_reservations.lab.reservations : r.address]
+}
@@ -0,0 +1,13 @@
+# Look up an IP block by its address.
+data "netdot_ipblock" "servers" {
+  address = "184.171.0.0/24"
+}
+
+# Find the most specific subnet or container holding an address instead.
+data "netdot_ipblock" "vm_subnet" {
+  containing = "184.171.0.37"
+}
+
+output "vm_subnet_owner" {
+  value = data.netdot_ipblock.vm_subnet.owner
+}
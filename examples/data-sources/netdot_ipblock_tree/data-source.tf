@@ -0,0 +1,12 @@
+data "netdot_ipblock_tree" "campus" {
+  root      = "184.171.0.0/16"
+  max_depth = 2
+}
+
+# One entry per subnet under the campus container.
+locals {
+  campus_subnets = {
+    for node in data.netdot_ipblock_tree.campus.nodes : node.cidr => node
+    if node.status == "Subnet"
+  }
+}
@@ -0,0 +1,8 @@
+# Look up an MX record by its netdot ID
+data "netdot_mx" "example" {
+  id = 1201
+}
+
+output "mail_exchanger" {
+  value = "${data.netdot_mx.example.preference} ${data.netdot_mx.example.exchange}"
+}
@@ -0,0 +1,7 @@
+data "netdot_ptr" "gateway" {
+  ipblock = "184.171.2.2"
+}
+
+output "gateway_name" {
+  value = data.netdot_ptr.gateway.ptrdname
+}
@@ -0,0 +1,9 @@
+# Look up the SRV record of _ldap._tcp.uoregon.edu that points at ldap1.uoregon.edu
+data "netdot_srv" "ldap" {
+  rr_id  = 4711
+  target = "ldap1.uoregon.edu"
+}
+
+output "ldap_endpoint" {
+  value = "${data.netdot_srv.ldap.target}:${data.netdot_srv.ldap.port}"
+}
@@ -0,0 +1,11 @@
+# Warn when a subnet is running out of room.
+data "netdot_subnet_utilization" "servers" {
+  subnet = "184.171.0.0/24"
+}
+
+check "servers_subnet_capacity" {
+  assert {
+    condition     = data.netdot_subnet_utilization.servers.percent_used < 90
+    error_message = "${data.netdot_subnet_utilization.servers.subnet} is ${floor(data.netdot_subnet_utilization.servers.percent_used)}% used."
+  }
+}
@@ -0,0 +1,7 @@
+data "netdot_txt" "spf" {
+  id = 3021
+}
+
+output "spf_policy" {
+  value = data.netdot_txt.spf.value
+}
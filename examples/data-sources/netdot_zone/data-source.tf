@@ -0,0 +1,7 @@
+data "netdot_zone" "uoregon" {
+  name = "uoregon.edu"
+}
+
+output "uoregon_soa" {
+  value = "${data.netdot_zone.uoregon.mname} ${data.netdot_zone.uoregon.rname} ${data.netdot_zone.uoregon.serial}"
+}
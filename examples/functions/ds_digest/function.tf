@@ -0,0 +1,11 @@
+# the SHA-256 digest for the DS record of a key signing key
+output "sub_ds_digest" {
+  value = provider::netdot::ds_digest(
+    "sub.uoregon.edu",
+    257,
+    3,
+    13,
+    "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==",
+    2,
+  )
+}
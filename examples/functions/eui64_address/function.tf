@@ -0,0 +1,4 @@
+# 2001:db8:1:2:211:22ff:fe33:4455
+output "printer_v6" {
+  value = provider::netdot::eui64_address("2001:db8:1:2::/64", "00:11:22:33:44:55")
+}
@@ -0,0 +1,3 @@
+output "web_v6" {
+  value = provider::netdot::stable_address("2001:db8:1:2::/64", "web01.uoregon.edu")
+}
@@ -12,10 +12,13 @@ resource "netdot_rr" "foo" {
   zone = "uoregon.edu"
 }
 
-# Finally attach an A record to the resource record pointing to the desired IP address
+# Finally attach an A record to the resource record pointing to the desired IP address.
+# manage_ptr also creates the matching PTR record (222.0.171.184.in-addr.arpa -> foo.uoregon.edu),
+# which is renamed with the RR and deleted together with the A record.
 resource "netdot_arecord" "foo" {
   rr_id      = netdot_rr.foo.id
   ipblock_id = netdot_ipblock.foo.id
+  manage_ptr = true
 }
 
 # If you wanted to implement DNS based load balancing, you could create multiple A records for the same resource record
@@ -0,0 +1,43 @@
+# Here is how you would give web01.uoregon.edu both an IPv4 and an IPv6 address.
+# netdot_arecord makes an A record for an IPv4 ipblock and an AAAA record for an IPv6 ipblock.
+
+# Look up the IPv4 and IPv6 subnets the host lives in.
+data "netdot_ipblock" "servers_v4" {
+  address = "184.171.0.0"
+}
+
+data "netdot_ipblock" "servers_v6" {
+  address = "2607:8400:2880:4::"
+}
+
+# Take the next free address of each subnet.
+resource "netdot_ipblock" "web01_v4" {
+  parent_id   = data.netdot_ipblock.servers_v4.id
+  description = "web01"
+}
+
+resource "netdot_ipblock" "web01_v6" {
+  parent_id   = data.netdot_ipblock.servers_v6.id
+  description = "web01"
+}
+
+resource "netdot_rr" "web01" {
+  name = "web01"
+  zone = "uoregon.edu"
+}
+
+# record_type is optional, setting it makes the plan fail when the ipblock is of the other family.
+resource "netdot_arecord" "web01_a" {
+  rr_id       = netdot_rr.web01.id
+  ipblock_id  = netdot_ipblock.web01_v4.id
+  record_type = "A"
+  manage_ptr  = true
+}
+
+# The PTR of an IPv6 address lives in the nibble format reverse zone, e.g. under 4.0.0.0.0.8.8.2.0.0.4.8.7.0.6.2.ip6.arpa
+resource "netdot_arecord" "web01_aaaa" {
+  rr_id       = netdot_rr.web01.id
+  ipblock_id  = netdot_ipblock.web01_v6.id
+  record_type = "AAAA"
+  manage_ptr  = true
+}
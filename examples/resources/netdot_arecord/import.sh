@@ -0,0 +1,3 @@
+# A records can be imported by their netdot ID or by "fqdn,ip"
+terraform import netdot_arecord.example 544859
+terraform import netdot_arecord.www www.uoregon.edu,184.171.15.38
@@ -0,0 +1,2 @@
+# CAA records can be imported by their netdot ID
+terraform import netdot_caa.issue 71
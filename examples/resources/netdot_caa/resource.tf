@@ -0,0 +1,25 @@
+resource "netdot_rr" "apex" {
+  name = "@"
+  zone = "uoregon.edu"
+}
+
+# only Let's Encrypt may issue certificates for uoregon.edu
+resource "netdot_caa" "issue" {
+  rr_id = netdot_rr.apex.id
+  tag   = "issue"
+  value = "letsencrypt.org"
+}
+
+# nobody may issue wildcard certificates
+resource "netdot_caa" "issuewild" {
+  rr_id = netdot_rr.apex.id
+  tag   = "issuewild"
+  value = ";"
+}
+
+# report refused requests to the security team
+resource "netdot_caa" "iodef" {
+  rr_id = netdot_rr.apex.id
+  tag   = "iodef"
+  value = "mailto:security@uoregon.edu"
+}
@@ -0,0 +1,3 @@
+# CNAME records can be imported by their netdot ID or by the FQDN of the alias
+terraform import netdot_cname.example 12345
+terraform import netdot_cname.alias alias.uoregon.edu
@@ -0,0 +1,2 @@
+# DNSKEY records can be imported by their netdot ID
+terraform import netdot_dnskey.sub_ksk 12
@@ -0,0 +1,12 @@
+resource "netdot_rr" "sub_apex" {
+  name = "@"
+  zone = "sub.uoregon.edu"
+}
+
+# the key signing key of sub.uoregon.edu, as printed in the K*.key file of the signer
+resource "netdot_dnskey" "sub_ksk" {
+  rr_id      = netdot_rr.sub_apex.id
+  flags      = 257
+  algorithm  = 13
+  public_key = "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ=="
+}
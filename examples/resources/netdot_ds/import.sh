@@ -0,0 +1,2 @@
+# DS records can be imported by their netdot ID
+terraform import netdot_ds.sub 24
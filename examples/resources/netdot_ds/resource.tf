@@ -0,0 +1,21 @@
+# the delegation of sub.uoregon.edu in its parent zone
+resource "netdot_rr" "sub" {
+  name = "sub"
+  zone = "uoregon.edu"
+}
+
+# point the parent at the key signing key of the child zone, see the netdot_dnskey example
+resource "netdot_ds" "sub" {
+  rr_id       = netdot_rr.sub.id
+  key_tag     = netdot_dnskey.sub_ksk.key_tag
+  algorithm   = netdot_dnskey.sub_ksk.algorithm
+  digest_type = 2
+  digest = provider::netdot::ds_digest(
+    netdot_dnskey.sub_ksk.rr,
+    netdot_dnskey.sub_ksk.flags,
+    netdot_dnskey.sub_ksk.protocol,
+    netdot_dnskey.sub_ksk.algorithm,
+    netdot_dnskey.sub_ksk.public_key,
+    2,
+  )
+}
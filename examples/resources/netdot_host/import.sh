@@ -0,0 +1,3 @@
+# Hosts can be imported by the netdot ID of their RR or by their FQDN
+terraform import netdot_host.web01 999301
+terraform import netdot_host.web01 web01.uoregon.edu
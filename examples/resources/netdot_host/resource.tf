@@ -0,0 +1,28 @@
+# Create web01.uoregon.edu with the next free address of a subnet, along with its A and PTR records.
+resource "netdot_host" "web01" {
+  name   = "web01"
+  zone   = "uoregon.edu"
+  subnet = "184.171.0.0/24"
+}
+
+# Create a host on a fixed address instead.
+resource "netdot_host" "printer" {
+  name    = "printer1"
+  zone    = "uoregon.edu"
+  address = "184.171.0.50"
+}
+
+# Create a dual-stack host with one address from each subnet, along with its A, AAAA and PTR records.
+resource "netdot_host" "web02" {
+  name    = "web02"
+  zone    = "uoregon.edu"
+  subnets = ["184.171.0.0/24", "2607:8400:2880:4::/64"]
+}
+
+output "web01_address" {
+  value = netdot_host.web01.address
+}
+
+output "web02_addresses" {
+  value = [for address in netdot_host.web02.addresses : address.address]
+}
@@ -0,0 +1,7 @@
+# IP blocks can be imported by their netdot ID
+terraform import netdot_ipblock.example 6577810709
+
+# or by address or CIDR
+terraform import netdot_ipblock.host 10.1.2.3
+terraform import netdot_ipblock.subnet 10.1.2.0/24
+terraform import netdot_ipblock.v6 2001:db8::/64
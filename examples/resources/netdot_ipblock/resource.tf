@@ -0,0 +1,24 @@
+# Take the next free address in a subnet.
+resource "netdot_ipblock" "host" {
+  parent_id   = 2711826724
+  description = "web01"
+}
+
+# Reserve the EUI-64 address of a MAC address in an IPv6 /64.
+resource "netdot_ipblock" "printer_v6" {
+  parent_id       = 2711826801
+  derive_from_mac = "00:11:22:33:44:55"
+}
+
+# Reserve an address derived from the hostname, the same name always gets the same address.
+resource "netdot_ipblock" "web_v6" {
+  parent_id        = 2711826801
+  derive_from_name = "web01.uoregon.edu"
+}
+
+# Reserve an address that the netdot_expired_reservations data source may reclaim after November 1st.
+resource "netdot_ipblock" "lab" {
+  parent_id      = 2711826724
+  description    = "lab test host"
+  reserved_until = "2026-11-01T00:00:00Z"
+}
@@ -0,0 +1,14 @@
+# Reserve a fixed block of addresses for a DHCP pool.
+resource "netdot_ipblock_range" "dhcp_pool" {
+  start       = "184.171.0.100"
+  end         = "184.171.0.199"
+  status      = "Dhcp"
+  description = "lab DHCP pool"
+}
+
+# Or let the provider find 20 contiguous free addresses in a subnet.
+resource "netdot_ipblock_range" "vips" {
+  parent_id   = 2711826724
+  size        = 20
+  description = "load balancer VIPs"
+}
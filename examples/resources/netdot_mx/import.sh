@@ -0,0 +1,3 @@
+# MX records can be imported by their netdot ID or by the FQDN of the owner and the exchange
+terraform import netdot_mx.example 12345
+terraform import netdot_mx.primary cs.uoregon.edu,mx1.uoregon.edu
@@ -0,0 +1,22 @@
+# Route mail for cs.uoregon.edu through two mail exchangers
+
+# create a resource record for cs.uoregon.edu
+resource "netdot_rr" "cs" {
+  name = "cs"
+  zone = "uoregon.edu"
+}
+
+# the primary mail exchanger, tried first
+resource "netdot_mx" "primary" {
+  rr_id      = netdot_rr.cs.id
+  exchange   = "mx1.uoregon.edu"
+  preference = 10
+}
+
+# the backup mail exchanger, the exchange must not be a CNAME
+resource "netdot_mx" "backup" {
+  rr_id      = netdot_rr.cs.id
+  exchange   = "mx2.uoregon.edu"
+  preference = 20
+  ttl        = 3600
+}
@@ -0,0 +1,5 @@
+# NS records can be imported by their netdot ID or by "fqdn,nsdname"
+terraform import netdot_ns.example 12345
+terraform import netdot_ns.sub sub.uoregon.edu,ns1.uoregon.edu
+# records at the apex of a zone are imported by the zone's name
+terraform import netdot_ns.apex uoregon.edu,ns1.uoregon.edu
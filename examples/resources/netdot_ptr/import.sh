@@ -0,0 +1,3 @@
+# PTR records can be imported by their netdot ID or by address
+terraform import netdot_ptr.example 448896
+terraform import netdot_ptr.web 184.171.2.2
@@ -0,0 +1,9 @@
+resource "netdot_ipblock" "web" {
+  parent_id = 2711826724
+}
+
+# Creates 38.15.171.184.in-addr.arpa (or the ip6.arpa name) in the reverse zone netdot has for the address.
+resource "netdot_ptr" "web" {
+  ipblock_id = netdot_ipblock.web.id
+  ptrdname   = "web01.uoregon.edu"
+}
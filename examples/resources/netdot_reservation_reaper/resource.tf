@@ -0,0 +1,13 @@
+# In a separate cleanup configuration, release every reservation under the lab subnet that ran out.
+# A new day releases them again on the next apply, plans never change netdot.
+resource "netdot_reservation_reaper" "lab" {
+  parent_id = 2711826724
+
+  triggers = {
+    day = formatdate("YYYY-MM-DD", plantimestamp())
+  }
+}
+
+output "released" {
+  value = [for r in netdot_reservation_reaper.lab.released : r.address]
+}
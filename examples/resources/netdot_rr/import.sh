@@ -0,0 +1,3 @@
+# RRs can be imported by their netdot ID or by FQDN
+terraform import netdot_rr.example 999289
+terraform import netdot_rr.www www.uoregon.edu
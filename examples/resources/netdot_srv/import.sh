@@ -0,0 +1,2 @@
+# SRV records can be imported by their netdot ID
+terraform import netdot_srv.ldap1 812
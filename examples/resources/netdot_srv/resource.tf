@@ -0,0 +1,21 @@
+# Publish the LDAP service of uoregon.edu, the resource record must be named _service._proto
+resource "netdot_rr" "ldap" {
+  name = "_ldap._tcp"
+  zone = "uoregon.edu"
+}
+
+resource "netdot_srv" "ldap1" {
+  rr_id    = netdot_rr.ldap.id
+  target   = "ldap1.uoregon.edu"
+  port     = 389
+  priority = 10
+  weight   = 60
+}
+
+resource "netdot_srv" "ldap2" {
+  rr_id    = netdot_rr.ldap.id
+  target   = "ldap2.uoregon.edu"
+  port     = 389
+  priority = 10
+  weight   = 40
+}
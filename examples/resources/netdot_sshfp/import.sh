@@ -0,0 +1,2 @@
+# SSHFP records can be imported by their netdot ID
+terraform import netdot_sshfp.host1_ed25519 455
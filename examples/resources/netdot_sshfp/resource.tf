@@ -0,0 +1,12 @@
+resource "netdot_rr" "host1" {
+  name = "host1"
+  zone = "uoregon.edu"
+}
+
+# publish the SHA-256 fingerprint of the Ed25519 host key, as printed by ssh-keygen -r host1.uoregon.edu
+resource "netdot_sshfp" "host1_ed25519" {
+  rr_id            = netdot_rr.host1.id
+  algorithm        = 4
+  fingerprint_type = 2
+  fingerprint      = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+}
@@ -0,0 +1,2 @@
+# subnet zone links can be imported by their netdot ID
+terraform import netdot_subnet_zone.reverse 42
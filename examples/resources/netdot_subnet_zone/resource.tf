@@ -0,0 +1,19 @@
+resource "netdot_ipblock" "subnet" {
+  address   = "10.20.30.0/24"
+  status    = "Subnet"
+  parent_id = 2711826724
+}
+
+# Link an existing reverse zone to the subnet
+resource "netdot_subnet_zone" "reverse" {
+  subnet_id = netdot_ipblock.subnet.id
+  zone_id   = 310
+}
+
+# Or let the ipblock find or create 31.20.10.in-addr.arpa itself, deleting it again when the block is destroyed
+resource "netdot_ipblock" "managed_subnet" {
+  address             = "10.20.31.0/24"
+  status              = "Subnet"
+  parent_id           = 2711826724
+  create_reverse_zone = true
+}
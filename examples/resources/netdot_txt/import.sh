@@ -0,0 +1,2 @@
+# TXT records can be imported by their netdot ID
+terraform import netdot_txt.spf 3021
@@ -0,0 +1,26 @@
+resource "netdot_rr" "apex" {
+  name = "@"
+  zone = "uoregon.edu"
+}
+
+# an SPF policy fits in a single character-string
+resource "netdot_txt" "spf" {
+  rr_id = netdot_rr.apex.id
+  value = "v=spf1 mx include:_spf.google.com -all"
+}
+
+resource "netdot_rr" "dkim" {
+  name = "selector1._domainkey"
+  zone = "uoregon.edu"
+}
+
+# a 2048 bit DKIM key is longer than 255 bytes, it is split into several strings in netdot
+resource "netdot_txt" "dkim" {
+  rr_id = netdot_rr.dkim.id
+  value = "v=DKIM1; k=rsa; p=${var.dkim_public_key}"
+  ttl   = 3600
+}
+
+variable "dkim_public_key" {
+  type = string
+}
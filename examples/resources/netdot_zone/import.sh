@@ -0,0 +1,3 @@
+# Zones can be imported by their netdot ID or by name
+terraform import netdot_zone.example 310
+terraform import netdot_zone.lab lab.uoregon.edu
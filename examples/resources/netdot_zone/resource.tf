@@ -0,0 +1,11 @@
+resource "netdot_zone" "lab" {
+  name        = "lab.uoregon.edu"
+  mname       = "ns1.uoregon.edu"
+  rname       = "hostmaster.uoregon.edu"
+  default_ttl = 3600
+}
+
+resource "netdot_rr" "www" {
+  name = "www"
+  zone = netdot_zone.lab.name
+}
@@ -0,0 +1,112 @@
+package netdot
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// DS digest types (RFC 4034, 4509 and 6605) and the length of their digests in hex
+var DSDigestLengths = map[int64]int{1: 40, 2: 64, 4: 96}
+
+// DNSKEYRData is the wire format of the RDATA of a DNSKEY record (RFC 4034, section 2.1).
+// The public key is base64, whitespace inside it is ignored as in zone files.
+func DNSKEYRData(flags int64, protocol int64, algorithm int64, publicKey string) ([]byte, error) {
+	if flags < 0 || flags > 65535 || protocol < 0 || protocol > 255 || algorithm < 0 || algorithm > 255 {
+		return nil, fmt.Errorf("flags must fit in 16 bits, protocol and algorithm in 8 bits")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(publicKey), ""))
+	if err != nil {
+		return nil, fmt.Errorf("public key is not valid base64: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("public key is empty")
+	}
+
+	rdata := []byte{byte(flags >> 8), byte(flags), byte(protocol), byte(algorithm)}
+	return append(rdata, key...), nil
+}
+
+// DNSKEYKeyTag computes the key tag of a DNSKEY, which DS records use to point at it (RFC 4034, appendix B)
+func DNSKEYKeyTag(flags int64, protocol int64, algorithm int64, publicKey string) (int64, error) {
+	rdata, err := DNSKEYRData(flags, protocol, algorithm, publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// RSA/MD5 keys use the low bits of their modulus instead
+	if algorithm == 1 {
+		if len(rdata) < 7 {
+			return 0, fmt.Errorf("public key is too short for RSA/MD5")
+		}
+		return int64(rdata[len(rdata)-3])<<8 | int64(rdata[len(rdata)-2]), nil
+	}
+
+	var ac uint32
+	for i, b := range rdata {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xffff
+
+	return int64(ac & 0xffff), nil
+}
+
+// DSDigest computes the digest of the DS record pointing at the DNSKEY of owner (RFC 4034, section 5.1.4),
+// the same value dnssec-dsfromkey prints
+func DSDigest(owner string, flags int64, protocol int64, algorithm int64, publicKey string, digestType int64) (string, error) {
+	var h hash.Hash
+	switch digestType {
+	case 1:
+		h = sha1.New()
+	case 2:
+		h = sha256.New()
+	case 4:
+		h = sha512.New384()
+	default:
+		return "", fmt.Errorf("digest type must be 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384), got %d", digestType)
+	}
+
+	name, err := canonicalWireName(owner)
+	if err != nil {
+		return "", err
+	}
+
+	rdata, err := DNSKEYRData(flags, protocol, algorithm, publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	h.Write(name)
+	h.Write(rdata)
+
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// lowercase wire format of a domain name, a sequence of length prefixed labels ending with the root label
+func canonicalWireName(name string) ([]byte, error) {
+	name = NormalizeFQDN(name)
+	if name == "" {
+		return []byte{0}, nil
+	}
+
+	wire := []byte{}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("%q is not a valid domain name, every label must be between 1 and 63 characters long", name)
+		}
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+
+	return append(wire, 0), nil
+}
@@ -2,16 +2,20 @@ package netdot
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/netip"
+	"sort"
+	"terraform-provider-netdot/internal/netdot/models"
 
 	"github.com/google/go-querystring/query"
 )
 
 type HostCreationData struct {
 	Name    string `url:"name"`
-	Subnet  string `url:"subnet"`
+	Subnet  string `url:"subnet,omitempty"`
 	Address string `url:"address,omitempty"`
 }
 
@@ -102,6 +106,7 @@ func (c *Client) DeleteHost(id int) error {
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
@@ -171,3 +176,241 @@ func (c *Client) GetHost(ip string) (RR, error) {
 
 	return hosts.RRs[0], nil
 }
+
+// one address of a host: its ipblock, the A or AAAA record pointing at it and the PTR pointing back,
+// which is nil when there is none
+type HostAddress struct {
+	IpBlock models.IpBlock
+	RRAddr  models.RRAddr
+	RRPtr   *models.RRPtr
+	Reused  bool // the ipblock was Available before the host took it, so it is released rather than deleted
+}
+
+// the records making up a host, its addresses in the order they were created
+type HostRecords struct {
+	RR        models.RR
+	Addresses []HostAddress
+}
+
+// HostFQDN is the name netdot's host endpoint expects for name in zone
+func HostFQDN(name, zone string) string {
+	return NormalizeFQDN(name) + "." + NormalizeFQDN(zone)
+}
+
+// load the records of the host whose RR has the given id, the status code is set when the RR could not be read
+func (c *Client) GetHostRecords(rrID int64) (HostRecords, *int, error) {
+	var records HostRecords
+	statusCode, err := c.GetResourceByID("rr", rrID, &records.RR)
+	if err != nil {
+		return HostRecords{}, statusCode, err
+	}
+	fqdn := HostFQDN(records.RR.Name, records.RR.Zone)
+
+	addrQuery := NewRRAddrQueryBuilder()
+	addrQuery.RR(rrID)
+	rraddrs, err := c.ListRRAddrs(addrQuery.Build())
+	if err != nil {
+		return HostRecords{}, nil, err
+	}
+	sort.Slice(rraddrs, func(i, j int) bool { return rraddrs[i].ID < rraddrs[j].ID })
+
+	for _, rraddr := range rraddrs {
+		address := HostAddress{RRAddr: rraddr}
+		_, err = c.GetResourceByID("ipblock", rraddr.IpBlockXlink.ID, &address.IpBlock)
+		if err != nil {
+			return HostRecords{}, nil, err
+		}
+
+		ptrQuery := NewRRPtrQueryBuilder()
+		ptrQuery.IpBlock(address.IpBlock.ID)
+		ptrs, err := c.ListRRPtrs(ptrQuery.Build())
+		if err != nil {
+			return HostRecords{}, nil, err
+		}
+		for _, ptr := range ptrs {
+			if NormalizeFQDN(ptr.PTRdname) == fqdn {
+				address.RRPtr = &ptr
+				break
+			}
+		}
+
+		records.Addresses = append(records.Addresses, address)
+	}
+
+	return records, nil, nil
+}
+
+// CreateHostRecords creates fqdn through the host endpoint, on the fixed address when it is set and otherwise
+// on the next free address of the first subnet. Every other subnet adds one more address with its A or AAAA
+// record. Each address gets a PTR. If any step fails, everything created so far is deleted again.
+func (c *Client) CreateHostRecords(fqdn string, address string, subnets []netip.Prefix) (HostRecords, error) {
+	var firstSubnet string
+	if address == "" {
+		if len(subnets) == 0 {
+			return HostRecords{}, fmt.Errorf("a host needs an address or a subnet")
+		}
+		firstSubnet = subnets[0].String()
+		subnets = subnets[1:]
+	}
+
+	rr, _, err := c.CreateHost(fqdn, firstSubnet, address)
+	if err != nil {
+		return HostRecords{}, err
+	}
+
+	records, err := c.completeHostRecords(int64(rr.ID), fqdn, subnets)
+	if err != nil {
+		if rollbackErr := c.DeleteHostRecords(int64(rr.ID), ReusedHostIpBlockIDs(records)); rollbackErr != nil {
+			return HostRecords{}, errors.Join(err, fmt.Errorf("Error rolling back %s, remove it by hand: %s", fqdn, rollbackErr.Error()))
+		}
+		return HostRecords{}, err
+	}
+
+	return records, nil
+}
+
+// add the PTR of the address the host endpoint created, then one address per subnet.
+// On failure the records added so far are returned along with the error.
+func (c *Client) completeHostRecords(rrID int64, fqdn string, subnets []netip.Prefix) (HostRecords, error) {
+	records, _, err := c.GetHostRecords(rrID)
+	if err != nil {
+		return HostRecords{}, err
+	}
+
+	if len(records.Addresses) == 0 {
+		return HostRecords{}, fmt.Errorf("netdot created %s without an address", fqdn)
+	}
+
+	// netdot does not always add the PTR itself
+	first := &records.Addresses[0]
+	if first.RRPtr == nil {
+		ptr, _, err := c.SetRRPtr(nil, first.IpBlock.ID, fqdn, first.RRAddr.TTL)
+		if err != nil {
+			return HostRecords{}, err
+		}
+		first.RRPtr = &ptr
+	}
+
+	for _, subnet := range subnets {
+		address, err := c.addHostAddress(rrID, fqdn, subnet, first.RRAddr.TTL)
+		if err != nil {
+			return records, fmt.Errorf("Error adding an address from %s: %s", subnet, err.Error())
+		}
+		records.Addresses = append(records.Addresses, address)
+	}
+
+	return records, nil
+}
+
+// allocate the next free address of subnet to the host's RR, with its address record and PTR
+func (c *Client) addHostAddress(rrID int64, fqdn string, subnet netip.Prefix, ttl int64) (HostAddress, error) {
+	parent, err := c.GetIpBlockByPrefix(subnet)
+	if err != nil {
+		return HostAddress{}, err
+	}
+
+	existingID, addrString, err := c.GetNextAvailableIP(parent.ID, IPAllocationStrategyFirstFree)
+	if err != nil {
+		return HostAddress{}, err
+	}
+	addr, err := netip.ParseAddr(addrString)
+	if err != nil {
+		return HostAddress{}, err
+	}
+
+	template := NewIpBlockQueryBuilder()
+	template.Status("Static")
+	template.ParentID(parent.ID)
+	ipblockQuery := HostIpBlockQuery(template.Build(), addr)
+
+	var address HostAddress
+	previouslyAvailable := map[netip.Addr]models.IpBlock{}
+	address.Reused = existingID != nil
+	if existingID != nil {
+		previouslyAvailable[addr] = models.IpBlock{ID: *existingID}
+		err = c.UpdateResource("ipblock", *existingID, ipblockQuery, &address.IpBlock)
+	} else {
+		err = c.CreateResource("ipblock", ipblockQuery, &address.IpBlock)
+	}
+	if err != nil {
+		return HostAddress{}, err
+	}
+
+	rraddrQuery := NewRRAddrQueryBuilder()
+	rraddrQuery.IpBlock(address.IpBlock.ID)
+	rraddrQuery.RR(rrID)
+	rraddrQuery.TTL(ttl)
+	err = c.CreateResource("rraddr", rraddrQuery.Build(), &address.RRAddr)
+	if err != nil {
+		// the ipblock is not attached to the host yet, so deleting the host would leave it behind
+		if rollbackErr := c.rollbackIpBlockRange([]models.IpBlock{address.IpBlock}, previouslyAvailable); rollbackErr != nil {
+			return HostAddress{}, errors.Join(err, rollbackErr)
+		}
+		return HostAddress{}, err
+	}
+
+	ptr, _, err := c.SetRRPtr(nil, address.IpBlock.ID, fqdn, ttl)
+	if err != nil {
+		return HostAddress{}, err
+	}
+	address.RRPtr = &ptr
+
+	return address, nil
+}
+
+// ids of the ipblocks of a host that were Available before the host took them
+func ReusedHostIpBlockIDs(records HostRecords) []int64 {
+	ids := []int64{}
+	for _, address := range records.Addresses {
+		if address.Reused {
+			ids = append(ids, address.IpBlock.ID)
+		}
+	}
+	return ids
+}
+
+// DeleteHostRecords deletes a host and all of its addresses. The PTRs go first, then the ipblocks in release
+// are detached from the host and returned to Available, the way a failed address is rolled back. Finally the
+// host endpoint deletes the RR along with the remaining address records and their ipblocks.
+func (c *Client) DeleteHostRecords(rrID int64, release []int64) error {
+	records, statusCode, err := c.GetHostRecords(rrID)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	releaseIDs := map[int64]bool{}
+	for _, id := range release {
+		releaseIDs[id] = true
+	}
+
+	for _, address := range records.Addresses {
+		if address.RRPtr != nil {
+			err = c.DeleteRRPtr(address.RRPtr.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !releaseIDs[address.IpBlock.ID] {
+			continue
+		}
+
+		rraddrQuery := NewRRAddrQueryBuilder()
+		rraddrQuery.SkipDeletingRR(true)
+		rraddrQuery.NoChangeStatus(true)
+		err = c.DeleteResourceByID("rraddr", address.RRAddr.ID, rraddrQuery.Build())
+		if err != nil {
+			return err
+		}
+
+		err = c.ReleaseIpBlock(address.IpBlock.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.DeleteHost(int(rrID))
+}
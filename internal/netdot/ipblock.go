@@ -1,9 +1,13 @@
 package netdot
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"net/http"
 	"net/netip"
+	"strings"
 	"terraform-provider-netdot/internal/netdot/models"
 )
 
@@ -153,74 +157,377 @@ type ipAllocationStrategy int
 
 const (
 	IPAllocationStrategyFirstFree ipAllocationStrategy = iota
-	// IPAllocationStrategyLastFree
+	IPAllocationStrategyLastFree
 )
 
+// map the strategy names used in terraform configuration to allocation strategies
+func ParseIPAllocationStrategy(strategy string) (ipAllocationStrategy, error) {
+	switch strategy {
+	case "first_free":
+		return IPAllocationStrategyFirstFree, nil
+	case "last_free":
+		return IPAllocationStrategyLastFree, nil
+	default:
+		return 0, fmt.Errorf("invalid allocation strategy %q, must be one of first_free or last_free", strategy)
+	}
+}
+
+// an address that can be handed out, ID is set when an Available ipblock already exists for it
+type AvailableIP struct {
+	ID      *int64
+	Address string
+}
+
 // get next available IP in Subnet IPblock, this could be
 func (c *Client) GetNextAvailableIP(subnetID int64, strategy ipAllocationStrategy) (*int64, string, error) {
+	availableIPs, _, err := c.GetAvailableIPs(subnetID, strategy, 1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(availableIPs) == 0 {
+		return nil, "", fmt.Errorf("No available IP address found")
+	}
+
+	return availableIPs[0].ID, availableIPs[0].Address, nil
+}
+
+// get up to count available IPs in a Subnet IPblock without reserving them, along with the total
+// number of free addresses in the subnet. The network address, the gateway (first host) and the
+// broadcast address (the reserved subnet anycast addresses for IPv6) are never considered available.
+func (c *Client) GetAvailableIPs(subnetID int64, strategy ipAllocationStrategy, count int) ([]AvailableIP, int64, error) {
 	if subnetID <= 0 {
-		return nil, "", fmt.Errorf("invalid subnetID")
+		return nil, 0, fmt.Errorf("invalid subnetID")
 	}
 
+	if count < 0 {
+		return nil, 0, fmt.Errorf("invalid count, must not be negative")
+	}
+
+	first, last, existingByAddress, err := c.getSubnetAllocationState(subnetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !first.IsValid() {
+		return []AvailableIP{}, 0, nil
+	}
+
+	var current netip.Addr
+	var step func(netip.Addr) netip.Addr
 	switch strategy {
 	case IPAllocationStrategyFirstFree:
-		return c.getFirstFreeIP(subnetID)
-	// case IPAllocationStrategyLastFree:
-	// 	return getLastFreeIP(subnetID)
+		current, step = first, netip.Addr.Next
+	case IPAllocationStrategyLastFree:
+		current, step = last, netip.Addr.Prev
 	default:
-		return nil, "", fmt.Errorf("invalid strategy")
+		return nil, 0, fmt.Errorf("invalid strategy")
 	}
-}
 
-func (c *Client) getFirstFreeIP(subnetID int64) (*int64, string, error) {
-	if subnetID <= 0 {
-		return nil, "", fmt.Errorf("invalid subnetID")
+	availableIPs := []AvailableIP{}
+	for len(availableIPs) < count && current.IsValid() && !current.Less(first) && !last.Less(current) {
+		existingIP, exists := existingByAddress[current]
+		if !exists {
+			availableIPs = append(availableIPs, AvailableIP{Address: current.String()})
+		} else if existingIP.Status == "Available" {
+			id := existingIP.ID
+			availableIPs = append(availableIPs, AvailableIP{ID: &id, Address: existingIP.Address})
+		}
+		current = step(current)
+	}
+
+	var used int64
+	for addr, ip := range existingByAddress {
+		if ip.Status != "Available" && !addr.Less(first) && !last.Less(addr) {
+			used++
+		}
 	}
 
+	free := addressCount(first, last)
+	free.Sub(free, big.NewInt(used))
+
+	return availableIPs, saturatedInt64(free), nil
+}
+
+// load a subnet's usable address range and its existing child ipblocks keyed by address.
+// first and last are invalid when the subnet is too small to hand out any address.
+func (c *Client) getSubnetAllocationState(subnetID int64) (netip.Addr, netip.Addr, map[netip.Addr]models.IpBlock, error) {
 	var subnetIpBlock models.IpBlock
-	statusCode, err := c.GetResourceByID("ipblock", subnetID, &subnetIpBlock)
+	_, err := c.GetResourceByID("ipblock", subnetID, &subnetIpBlock)
 	if err != nil {
-		return nil, "", fmt.Errorf("Error reading IP block", err.Error())
+		return netip.Addr{}, netip.Addr{}, nil, fmt.Errorf("Error reading IP block: %s", err.Error())
 	}
 
-	var existingIPs struct {
-		IpBlocks []models.IpBlock `xml:"Ipblock"`
+	childQuery := NewIpBlockQueryBuilder()
+	childQuery.ParentID(subnetID)
+	existingIPs, err := c.ListIpBlocks(childQuery.Build())
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, nil, fmt.Errorf("Error reading IP block: %s", err.Error())
 	}
 
-	statusCode, err = c.Get(fmt.Sprintf("/rest/ipblock?parent=%d", subnetID), &existingIPs)
+	parentPrefix, err := IpBlockPrefix(subnetIpBlock)
 	if err != nil {
-		if statusCode != nil && *statusCode != http.StatusNotFound {
-			return nil, "", fmt.Errorf("Error reading IP block", err.Error())
+		return netip.Addr{}, netip.Addr{}, nil, fmt.Errorf("Error parsing parent IP address: %s", err.Error())
+	}
+
+	existingByAddress := make(map[netip.Addr]models.IpBlock, len(existingIPs))
+	for _, ip := range existingIPs {
+		addr, err := netip.ParseAddr(ip.Address)
+		if err != nil {
+			continue
+		}
+		if _, ok := existingByAddress[addr]; !ok {
+			existingByAddress[addr] = ip
 		}
 	}
 
-	parentIP, err := netip.ParseAddr(subnetIpBlock.Address)
+	// start one address from gateway, just in case
+	first, last, _ := usableAddressRange(parentPrefix)
+
+	return first, last, existingByAddress, nil
+}
+
+// first and last addresses that can be handed out from a subnet, skipping the network address
+// (the subnet-router anycast address in IPv6) and the gateway. IPv4 subnets also lose their broadcast
+// address, IPv6 subnets have no broadcast but lose the subnet anycast addresses of RFC 2526 instead.
+func usableAddressRange(prefix netip.Prefix) (netip.Addr, netip.Addr, bool) {
+	prefix = prefix.Masked()
+	first := prefix.Addr().Next().Next()
+	last := lastAddress(prefix).Prev()
+	if prefix.Addr().Is6() && !prefix.Addr().Is4In6() && prefix.Bits() <= 120 {
+		// the top 128 interface identifiers are reserved, the broadcast address skipped above is one of them
+		for i := 0; i < rfc2526ReservedAnycastCount-1; i++ {
+			last = last.Prev()
+		}
+	}
+
+	if !first.IsValid() || !last.IsValid() || !prefix.Contains(first) || last.Less(first) {
+		return netip.Addr{}, netip.Addr{}, false
+	}
+
+	return first, last, true
+}
+
+// number of subnet anycast addresses reserved at the top of every IPv6 subnet
+const rfc2526ReservedAnycastCount = 128
+
+// last address of a prefix, the broadcast address for IPv4 subnets
+func lastAddress(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Masked().Addr().AsSlice()
+	bits := prefix.Bits()
+
+	for i := range bytes {
+		start := i * 8
+		if start+8 <= bits {
+			continue
+		}
+		if start >= bits {
+			bytes[i] = 0xff
+			continue
+		}
+		bytes[i] |= 0xff >> (bits - start)
+	}
+
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+// number of addresses from first to last inclusive
+func addressCount(first, last netip.Addr) *big.Int {
+	firstBytes := first.AsSlice()
+	lastBytes := last.AsSlice()
+
+	count := new(big.Int).SetBytes(lastBytes)
+	count.Sub(count, new(big.Int).SetBytes(firstBytes))
+	return count.Add(count, big.NewInt(1))
+}
+
+func saturatedInt64(i *big.Int) int64 {
+	if !i.IsInt64() {
+		if i.Sign() < 0 {
+			return 0
+		}
+		return math.MaxInt64
+	}
+	if i.Int64() < 0 {
+		return 0
+	}
+	return i.Int64()
+}
+
+type ipBlockSearchResults struct {
+	IpBlocks []models.IpBlock `xml:"Ipblock"`
+}
+
+// list every ipblock matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListIpBlocks(q IpBlockQuery) ([]models.IpBlock, error) {
+	var results ipBlockSearchResults
+	statusCode, err := c.Search("ipblock", q, &results)
 	if err != nil {
-		return nil, "", fmt.Errorf("Error parsing parent IP address", err.Error())
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.IpBlock{}, nil
+		}
+		return nil, err
 	}
 
-	parentPrefix := netip.PrefixFrom(parentIP, int(subnetIpBlock.Prefix))
+	return results.IpBlocks, nil
+}
 
-	// start one address from gateway, just in case
-	currentAddress := parentPrefix.Addr().Next().Next()
-
-	for parentPrefix.Contains(currentAddress.Next()) {
-		var existingIP *models.IpBlock = nil
-		for _, ip := range existingIPs.IpBlocks {
-			if ip.Address == currentAddress.String() {
-				existingIP = &ip
-				break
+// IpBlockPrefix returns the network prefix described by an ipblock's address and prefix length
+func IpBlockPrefix(ipblock models.IpBlock) (netip.Prefix, error) {
+	addr, err := netip.ParseAddr(ipblock.Address)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return addr.Prefix(int(ipblock.Prefix))
+}
+
+// get the single ipblock whose address and prefix length match the given prefix exactly
+func (c *Client) GetIpBlockByPrefix(prefix netip.Prefix) (models.IpBlock, error) {
+	queryBuilder := NewIpBlockQueryBuilder()
+	queryBuilder.Address(prefix.Addr().String())
+	queryBuilder.Prefix(int64(prefix.Bits()))
+
+	ipblocks, err := c.ListIpBlocks(queryBuilder.Build())
+	if err != nil {
+		return models.IpBlock{}, err
+	}
+
+	if len(ipblocks) == 0 {
+		return models.IpBlock{}, fmt.Errorf("no IP block found matching %s", prefix)
+	}
+
+	if len(ipblocks) > 1 {
+		return models.IpBlock{}, fmt.Errorf("%d IP blocks found matching %s", len(ipblocks), prefix)
+	}
+
+	return ipblocks[0], nil
+}
+
+// parse an address that may be written in CIDR notation, a plain address is treated as a host prefix.
+// The returned bool reports whether CIDR notation was used.
+func ParseAddressOrPrefix(s string) (netip.Prefix, bool, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, true, err
+		}
+		return prefix, true, nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, false, err
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), false, nil
+}
+
+// IpBlockVersion returns the IP version (4 or 6) of an address
+func IpBlockVersion(addr netip.Addr) int64 {
+	if addr.Is4() {
+		return 4
+	}
+	return 6
+}
+
+// whether an ipblock is a network that addresses are allocated from
+func IsNetworkIpBlock(ipblock models.IpBlock) bool {
+	return ipblock.Status == "Subnet" || ipblock.Status == "Container"
+}
+
+// returned by GetContainingIpBlock when no network holds the prefix, as opposed to failing to read them
+var errNoContainingIpBlock = errors.New("no Subnet or Container IP block contains")
+
+// find the most specific Subnet or Container ipblock holding the given prefix, a host prefix for a single address.
+// Netdot has no containment search, so the most specific container of the prefix's IP version is picked from
+// one search, falling back to the subnets when no container holds it, and the tree is walked down from there
+// one level of children at a time.
+func (c *Client) GetContainingIpBlock(prefix netip.Prefix) (models.IpBlock, error) {
+	prefix = prefix.Masked()
+
+	var containing models.IpBlock
+	found := false
+	for _, status := range []string{"Container", "Subnet"} {
+		queryBuilder := NewIpBlockQueryBuilder()
+		queryBuilder.Version(IpBlockVersion(prefix.Addr()))
+		queryBuilder.Status(status)
+
+		networks, err := c.ListIpBlocks(queryBuilder.Build())
+		if err != nil {
+			return models.IpBlock{}, fmt.Errorf("Error reading %s IP blocks: %s", status, err.Error())
+		}
+
+		containing, found = mostSpecificIpBlock(networks, prefix)
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		return models.IpBlock{}, fmt.Errorf("%w %s", errNoContainingIpBlock, prefix)
+	}
+
+	for {
+		childQuery := NewIpBlockQueryBuilder()
+		childQuery.ParentID(containing.ID)
+		children, err := c.ListIpBlocks(childQuery.Build())
+		if err != nil {
+			return models.IpBlock{}, fmt.Errorf("Error reading children of IP block %d: %s", containing.ID, err.Error())
+		}
+
+		var networks []models.IpBlock
+		for _, child := range children {
+			if IsNetworkIpBlock(child) {
+				networks = append(networks, child)
 			}
 		}
-		if existingIP == nil {
-			return nil, currentAddress.String(), nil
+
+		child, ok := mostSpecificIpBlock(networks, prefix)
+		if !ok {
+			return containing, nil
+		}
+		containing = child
+	}
+}
+
+// the ipblock with the longest prefix holding prefix
+func mostSpecificIpBlock(ipblocks []models.IpBlock, prefix netip.Prefix) (models.IpBlock, bool) {
+	var best models.IpBlock
+	bestBits := -1
+	for _, ipblock := range ipblocks {
+		candidate, err := IpBlockPrefix(ipblock)
+		if err != nil {
+			continue
+		}
+		candidate = candidate.Masked()
+
+		if candidate.Bits() <= prefix.Bits() && candidate.Bits() > bestBits && candidate.Contains(prefix.Addr()) {
+			best = ipblock
+			bestBits = candidate.Bits()
 		}
+	}
+
+	return best, bestBits >= 0
+}
 
-		if *&existingIP.Status == "Available" {
-			return &existingIP.ID, existingIP.Address, nil
+// follow parent links from an ipblock up to the root of its tree, nearest parent first
+func (c *Client) GetIpBlockAncestors(ipblock models.IpBlock) ([]models.IpBlock, error) {
+	ancestors := []models.IpBlock{}
+	seen := map[int64]bool{ipblock.ID: true}
+
+	for parentID := ipblock.ParentXlink.ID; parentID > 0 && !seen[parentID]; {
+		seen[parentID] = true
+
+		var parent models.IpBlock
+		_, err := c.GetResourceByID("ipblock", parentID, &parent)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading IP block %d: %s", parentID, err.Error())
 		}
-		currentAddress = currentAddress.Next()
+
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentXlink.ID
 	}
 
-	return nil, "", fmt.Errorf("No available IP address found")
+	return ancestors, nil
 }
@@ -0,0 +1,108 @@
+package netdot
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// EUI64Address builds the modified EUI-64 address (RFC 4291 appendix A) of a 48-bit MAC inside an IPv6 /64
+func EUI64Address(prefix netip.Prefix, mac string) (netip.Addr, error) {
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() || prefix.Bits() != 64 {
+		return netip.Addr{}, fmt.Errorf("EUI-64 addresses need an IPv6 /64, got %s", prefix)
+	}
+
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if len(hwAddr) != 6 {
+		return netip.Addr{}, fmt.Errorf("EUI-64 addresses need a 48-bit MAC address, got %s", mac)
+	}
+
+	bytes := prefix.Masked().Addr().As16()
+	bytes[8] = hwAddr[0] ^ 0x02
+	bytes[9] = hwAddr[1]
+	bytes[10] = hwAddr[2]
+	bytes[11] = 0xff
+	bytes[12] = 0xfe
+	bytes[13] = hwAddr[3]
+	bytes[14] = hwAddr[4]
+	bytes[15] = hwAddr[5]
+
+	return netip.AddrFrom16(bytes), nil
+}
+
+// StableHashAddress derives an address inside an IPv6 prefix from a SHA-256 hash of name,
+// so the same name always lands on the same address. Names are compared case-insensitively.
+// Only the addresses netdot_available_addresses allocates from are used, a hash landing on the network address,
+// the gateway or an RFC 2526 anycast address is replaced by the hash of name#1, name#2, and so on.
+func StableHashAddress(prefix netip.Prefix, name string) (netip.Addr, error) {
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() || prefix.Bits() > 120 {
+		return netip.Addr{}, fmt.Errorf("stable addresses need an IPv6 prefix of /120 or shorter, got %s", prefix)
+	}
+
+	name = NormalizeFQDN(name)
+	if name == "" {
+		return netip.Addr{}, fmt.Errorf("stable addresses need a non-empty name")
+	}
+
+	first, last, ok := usableAddressRange(prefix)
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("%s has no usable addresses", prefix)
+	}
+
+	for attempt := 0; attempt < maxStableHashAttempts; attempt++ {
+		input := name
+		if attempt > 0 {
+			input = fmt.Sprintf("%s#%d", name, attempt)
+		}
+
+		addr := hashIntoPrefix(prefix, sha256.Sum256([]byte(input)))
+		if !addr.Less(first) && !last.Less(addr) {
+			return addr, nil
+		}
+	}
+
+	return netip.Addr{}, fmt.Errorf("no usable address in %s found for %s after %d attempts", prefix, name, maxStableHashAttempts)
+}
+
+// a /120 has 126 usable addresses out of 256, so running out of attempts is practically impossible
+const maxStableHashAttempts = 64
+
+// hashIntoPrefix fills the host bits of prefix with the leading bits of hash
+func hashIntoPrefix(prefix netip.Prefix, hash [sha256.Size]byte) netip.Addr {
+	bytes := prefix.Masked().Addr().As16()
+	for i := prefix.Bits(); i < 128; i++ {
+		if hash[i/8]&(0x80>>(i%8)) != 0 {
+			bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+
+	return netip.AddrFrom16(bytes)
+}
+
+// check that addr has no ipblock, or only an Available one that can be reused.
+// The id of a reusable Available block is returned, nil means the address has no block at all.
+func (c *Client) CheckAddressFree(addr netip.Addr) (*int64, error) {
+	queryBuilder := NewIpBlockQueryBuilder()
+	queryBuilder.Address(addr.String())
+	queryBuilder.Prefix(int64(addr.BitLen()))
+
+	ipblocks, err := c.ListIpBlocks(queryBuilder.Build())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ipblocks) == 0 {
+		return nil, nil
+	}
+
+	if len(ipblocks) > 1 || !strings.EqualFold(ipblocks[0].Status, "Available") {
+		return nil, fmt.Errorf("address %s is already in use (status %s, id %d)", addr, ipblocks[0].Status, ipblocks[0].ID)
+	}
+
+	return &ipblocks[0].ID, nil
+}
@@ -0,0 +1,210 @@
+package netdot
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+// the largest number of addresses a single range may hold, ranges are reserved one ipblock at a time
+const MaxIpBlockRangeSize = 4096
+
+var bigMaxIpBlockRangeSize = big.NewInt(MaxIpBlockRangeSize)
+
+// every address from start to end inclusive
+func AddressesInRange(start, end netip.Addr) ([]netip.Addr, error) {
+	if !start.IsValid() || !end.IsValid() {
+		return nil, fmt.Errorf("invalid address range")
+	}
+
+	if start.Is4() != end.Is4() {
+		return nil, fmt.Errorf("start %s and end %s are not the same IP version", start, end)
+	}
+
+	if end.Less(start) {
+		return nil, fmt.Errorf("start %s is after end %s", start, end)
+	}
+
+	if addressCount(start, end).Cmp(bigMaxIpBlockRangeSize) > 0 {
+		return nil, fmt.Errorf("range %s-%s holds more than %d addresses", start, end, MaxIpBlockRangeSize)
+	}
+
+	addresses := []netip.Addr{}
+	for current := start; current.IsValid() && !end.Less(current); current = current.Next() {
+		addresses = append(addresses, current)
+	}
+
+	return addresses, nil
+}
+
+// find the first run of size consecutive available addresses in a Subnet IPblock
+func (c *Client) GetAvailableRange(subnetID int64, size int) (netip.Addr, netip.Addr, error) {
+	if subnetID <= 0 {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid subnetID")
+	}
+
+	if size <= 0 || size > MaxIpBlockRangeSize {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid size, must be between 1 and %d", MaxIpBlockRangeSize)
+	}
+
+	first, last, existingByAddress, err := c.getSubnetAllocationState(subnetID)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+
+	var runStart netip.Addr
+	runLength := 0
+	for current := first; current.IsValid() && !last.Less(current); current = current.Next() {
+		if existingIP, exists := existingByAddress[current]; exists && existingIP.Status != "Available" {
+			runLength = 0
+			continue
+		}
+
+		if runLength == 0 {
+			runStart = current
+		}
+		runLength++
+
+		if runLength == size {
+			return runStart, current, nil
+		}
+	}
+
+	return netip.Addr{}, netip.Addr{}, fmt.Errorf("No range of %d available IP addresses found", size)
+}
+
+// copy of the template query for a single host address
+func HostIpBlockQuery(template IpBlockQuery, addr netip.Addr) IpBlockQuery {
+	queryBuilder := template.Builder()
+	queryBuilder.Address(addr.String())
+	queryBuilder.Prefix(int64(addr.BitLen()))
+	queryBuilder.Version(IpBlockVersion(addr))
+	return queryBuilder.Build()
+}
+
+// reserve every address in the list as an ipblock built from the template query. Addresses that already
+// exist must be Available, they are returned as well so they can be released instead of deleted later.
+// If any address fails, everything reserved so far is rolled back.
+func (c *Client) ReserveIpBlockRange(addresses []netip.Addr, template IpBlockQuery) ([]models.IpBlock, []netip.Addr, error) {
+	existing := make(map[netip.Addr]models.IpBlock, len(addresses))
+	var taken []string
+
+	for _, addr := range addresses {
+		lookup := NewIpBlockQueryBuilder()
+		lookup.Address(addr.String())
+		lookup.Prefix(int64(addr.BitLen()))
+
+		matches, err := c.ListIpBlocks(lookup.Build())
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error reading IP block %s: %s", addr, err.Error())
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+
+		if matches[0].Status != "Available" {
+			taken = append(taken, fmt.Sprintf("%s (%s)", addr, matches[0].Status))
+			continue
+		}
+		existing[addr] = matches[0]
+	}
+
+	if len(taken) > 0 {
+		return nil, nil, fmt.Errorf("addresses already in use: %s", strings.Join(taken, ", "))
+	}
+
+	reserved := make([]models.IpBlock, 0, len(addresses))
+	for _, addr := range addresses {
+		var ipblock models.IpBlock
+		var err error
+		if existingIP, ok := existing[addr]; ok {
+			err = c.UpdateResource("ipblock", existingIP.ID, HostIpBlockQuery(template, addr), &ipblock)
+		} else {
+			err = c.CreateResource("ipblock", HostIpBlockQuery(template, addr), &ipblock)
+		}
+
+		if err != nil {
+			reserveErr := fmt.Errorf("Error reserving %s: %s", addr, err.Error())
+			if rollbackErr := c.rollbackIpBlockRange(reserved, existing); rollbackErr != nil {
+				return nil, nil, errors.Join(reserveErr, rollbackErr)
+			}
+			return nil, nil, reserveErr
+		}
+
+		reserved = append(reserved, ipblock)
+	}
+
+	previouslyAvailable := make([]netip.Addr, 0, len(existing))
+	for _, addr := range addresses {
+		if _, ok := existing[addr]; ok {
+			previouslyAvailable = append(previouslyAvailable, addr)
+		}
+	}
+
+	return reserved, previouslyAvailable, nil
+}
+
+// undo a partially reserved range, blocks that were Available beforehand are returned to Available
+func (c *Client) rollbackIpBlockRange(reserved []models.IpBlock, previouslyAvailable map[netip.Addr]models.IpBlock) error {
+	var rollbackErrors []error
+
+	for _, ipblock := range reserved {
+		addr, err := netip.ParseAddr(ipblock.Address)
+		if err == nil {
+			if _, ok := previouslyAvailable[addr]; ok {
+				err = c.ReleaseIpBlock(ipblock.ID)
+				if err != nil {
+					rollbackErrors = append(rollbackErrors, fmt.Errorf("Error rolling back %s: %s", ipblock.Address, err.Error()))
+				}
+				continue
+			}
+		}
+
+		err = c.DeleteResourceByID("ipblock", ipblock.ID, nil)
+		if err != nil {
+			rollbackErrors = append(rollbackErrors, fmt.Errorf("Error rolling back %s: %s", ipblock.Address, err.Error()))
+		}
+	}
+
+	return errors.Join(rollbackErrors...)
+}
+
+// return an ipblock to the Available status, clearing who it was reserved for
+// owner, used_by and any reservation marker are cleared, everything else is kept
+func (c *Client) ReleaseIpBlock(id int64) error {
+	var ipblock models.IpBlock
+	_, err := c.GetResourceByID("ipblock", id, &ipblock)
+	if err != nil {
+		return err
+	}
+
+	releaseQuery := NewIpBlockQueryBuilder()
+	releaseQuery.Address(ipblock.Address)
+	releaseQuery.Prefix(ipblock.Prefix)
+	releaseQuery.Version(ipblock.Version)
+	releaseQuery.Status("Available")
+	releaseQuery.Description("")
+	releaseQuery.Info(SetReservedUntil(ipblock.Info, nil))
+	releaseQuery.Monitored(ipblock.Monitored)
+	releaseQuery.RIR(ipblock.RIR)
+	releaseQuery.UseNetworkBroadcast(ipblock.UseNetworkBroadcast)
+	if ipblock.AsnXlink.ID > 0 {
+		releaseQuery.ASNID(ipblock.AsnXlink.ID)
+	}
+	if ipblock.InterfaceXlink.ID > 0 {
+		releaseQuery.InterfaceID(ipblock.InterfaceXlink.ID)
+	}
+	if ipblock.ParentXlink.ID > 0 {
+		releaseQuery.ParentID(ipblock.ParentXlink.ID)
+	}
+	if ipblock.VLANXlink.ID > 0 {
+		releaseQuery.VLANID(ipblock.VLANXlink.ID)
+	}
+
+	var released models.IpBlock
+	return c.UpdateResource("ipblock", id, releaseQuery.Build(), &released)
+}
@@ -0,0 +1,97 @@
+package netdot
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+	"time"
+)
+
+// line kept in an ipblock's info field to mark when a reservation made by terraform runs out
+const ReservedUntilMarker = "terraform-reserved-until:"
+
+type IpBlockReservation struct {
+	IpBlock       models.IpBlock
+	ReservedUntil time.Time
+}
+
+// ParseReservedUntil splits the reservation marker off an info field.
+// until is nil when info has no marker, rest is info without the marker line.
+func ParseReservedUntil(info string) (*time.Time, string, error) {
+	lines := strings.Split(info, "\n")
+	rest := []string{}
+	var until *time.Time
+
+	for _, line := range lines {
+		value, found := strings.CutPrefix(strings.TrimSpace(line), ReservedUntilMarker)
+		if !found {
+			rest = append(rest, line)
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+		if err != nil {
+			return nil, info, fmt.Errorf("invalid reservation marker %q: %s", line, err)
+		}
+		until = &parsed
+	}
+
+	return until, strings.Join(rest, "\n"), nil
+}
+
+// SetReservedUntil replaces the reservation marker in an info field, a nil until only removes it
+func SetReservedUntil(info string, until *time.Time) string {
+	_, rest, err := ParseReservedUntil(info)
+	if err != nil {
+		// drop the broken marker rather than keep it around
+		lines := []string{}
+		for _, line := range strings.Split(info, "\n") {
+			if !strings.HasPrefix(strings.TrimSpace(line), ReservedUntilMarker) {
+				lines = append(lines, line)
+			}
+		}
+		rest = strings.Join(lines, "\n")
+	}
+
+	if until == nil {
+		return rest
+	}
+
+	marker := ReservedUntilMarker + " " + until.UTC().Format(time.RFC3339)
+	if rest == "" {
+		return marker
+	}
+	return rest + "\n" + marker
+}
+
+// list the reservations that ran out before at. Only direct children of parentID are searched,
+// every ipblock is when parentID is nil. Blocks that are already Available are skipped.
+func (c *Client) ListExpiredReservations(parentID *int64, at time.Time) ([]IpBlockReservation, error) {
+	queryBuilder := NewIpBlockQueryBuilder()
+	if parentID != nil {
+		queryBuilder.ParentID(*parentID)
+	}
+
+	ipblocks, err := c.ListIpBlocks(queryBuilder.Build())
+	if err != nil {
+		return nil, err
+	}
+
+	expired := []IpBlockReservation{}
+	for _, ipblock := range ipblocks {
+		if strings.EqualFold(ipblock.Status, "Available") {
+			continue
+		}
+
+		until, _, err := ParseReservedUntil(ipblock.Info)
+		if err != nil || until == nil {
+			continue
+		}
+
+		if until.Before(at) {
+			expired = append(expired, IpBlockReservation{IpBlock: ipblock, ReservedUntil: *until})
+		}
+	}
+
+	return expired, nil
+}
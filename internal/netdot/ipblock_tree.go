@@ -0,0 +1,171 @@
+package netdot
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+// an ipblock within a tree of ipblocks, along with its place in the tree and its utilization
+type IpBlockTreeNode struct {
+	IpBlock     models.IpBlock
+	Depth       int
+	ChildIDs    []int64
+	Utilization IpBlockUtilization
+}
+
+// get an ipblock and its descendants down to maxDepth levels below it (unlimited when maxDepth is negative),
+// in depth first order with children sorted by address. Single address blocks are only returned when includeHosts
+// is set, but always count towards their parent's utilization.
+func (c *Client) GetIpBlockTree(rootID int64, maxDepth int, includeHosts bool) ([]IpBlockTreeNode, error) {
+	var root models.IpBlock
+	_, err := c.GetResourceByID("ipblock", rootID, &root)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IP block: %s", err.Error())
+	}
+
+	nodes := []IpBlockTreeNode{}
+	err = c.walkIpBlockTree(root, 0, maxDepth, includeHosts, &nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func (c *Client) walkIpBlockTree(ipblock models.IpBlock, depth, maxDepth int, includeHosts bool, nodes *[]IpBlockTreeNode) error {
+	childQuery := NewIpBlockQueryBuilder()
+	childQuery.ParentID(ipblock.ID)
+	children, err := c.ListIpBlocks(childQuery.Build())
+	if err != nil {
+		return fmt.Errorf("Error reading children of IP block %d: %s", ipblock.ID, err.Error())
+	}
+
+	utilization, err := ComputeIpBlockUtilization(ipblock, children)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(children, func(i, j int) bool {
+		a, errA := IpBlockPrefix(children[i])
+		b, errB := IpBlockPrefix(children[j])
+		if errA != nil || errB != nil {
+			return children[i].ID < children[j].ID
+		}
+		if order := a.Addr().Compare(b.Addr()); order != 0 {
+			return order < 0
+		}
+		return a.Bits() < b.Bits()
+	})
+
+	var listedChildren []models.IpBlock
+	if maxDepth < 0 || depth < maxDepth {
+		for _, child := range children {
+			if !includeHosts && isHostIpBlock(child) {
+				continue
+			}
+			listedChildren = append(listedChildren, child)
+		}
+	}
+
+	node := IpBlockTreeNode{
+		IpBlock:     ipblock,
+		Depth:       depth,
+		ChildIDs:    make([]int64, 0, len(listedChildren)),
+		Utilization: utilization,
+	}
+	for _, child := range listedChildren {
+		node.ChildIDs = append(node.ChildIDs, child.ID)
+	}
+	*nodes = append(*nodes, node)
+
+	for _, child := range listedChildren {
+		if isHostIpBlock(child) {
+			hostUtilization, err := ComputeIpBlockUtilization(child, nil)
+			if err != nil {
+				return err
+			}
+			*nodes = append(*nodes, IpBlockTreeNode{IpBlock: child, Depth: depth + 1, ChildIDs: []int64{}, Utilization: hostUtilization})
+			continue
+		}
+
+		err := c.walkIpBlockTree(child, depth+1, maxDepth, includeHosts, nodes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// list the ipblocks overlapping within by walking down the tree from the network holding it, so only that part of
+// the tree is read. Children of blocks with a prefix length of maxPrefix or more are not read when maxPrefix is not
+// negative.
+func (c *Client) ListIpBlocksWithin(within netip.Prefix, maxPrefix int) ([]models.IpBlock, error) {
+	within = within.Masked()
+
+	var roots []models.IpBlock
+	container, err := c.GetContainingIpBlock(within)
+	switch {
+	case err == nil:
+		roots = []models.IpBlock{container}
+	case errors.Is(err, errNoContainingIpBlock):
+		// nothing holds within, so start from every network inside it
+		for _, status := range []string{"Container", "Subnet"} {
+			queryBuilder := NewIpBlockQueryBuilder()
+			queryBuilder.Version(IpBlockVersion(within.Addr()))
+			queryBuilder.Status(status)
+			networks, err := c.ListIpBlocks(queryBuilder.Build())
+			if err != nil {
+				return nil, err
+			}
+			roots = append(roots, networks...)
+		}
+	default:
+		return nil, err
+	}
+
+	ipblocks := []models.IpBlock{}
+	seen := map[int64]bool{}
+	for queue := roots; len(queue) > 0; queue = queue[1:] {
+		ipblock := queue[0]
+		if seen[ipblock.ID] {
+			continue
+		}
+		seen[ipblock.ID] = true
+
+		prefix, err := IpBlockPrefix(ipblock)
+		if err != nil {
+			return nil, fmt.Errorf("IP block %d has an invalid address: %s", ipblock.ID, err)
+		}
+		if !prefix.Overlaps(within) {
+			continue
+		}
+		ipblocks = append(ipblocks, ipblock)
+
+		if isHostIpBlock(ipblock) || (maxPrefix >= 0 && prefix.Bits() >= maxPrefix) {
+			continue
+		}
+
+		childQuery := NewIpBlockQueryBuilder()
+		childQuery.ParentID(ipblock.ID)
+		children, err := c.ListIpBlocks(childQuery.Build())
+		if err != nil {
+			return nil, fmt.Errorf("Error reading children of IP block %d: %s", ipblock.ID, err.Error())
+		}
+		queue = append(queue, children...)
+	}
+
+	return ipblocks, nil
+}
+
+// whether an ipblock is a single address
+func isHostIpBlock(ipblock models.IpBlock) bool {
+	prefix, err := IpBlockPrefix(ipblock)
+	if err != nil {
+		return false
+	}
+	return prefix.Bits() == prefix.Addr().BitLen()
+}
@@ -0,0 +1,164 @@
+package netdot
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"sort"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+// address usage of an ipblock, broken down by the status of its children
+type IpBlockUtilization struct {
+	Total        int64
+	Used         int64
+	Free         int64
+	Unallocated  int64 // the part of Free not covered by any child, the rest is Available children
+	PercentUsed  float64
+	StatusCounts map[string]int64
+	// largest run of consecutive addresses not covered by a used child, invalid when the block is full
+	LargestFreeRangeStart netip.Addr
+	LargestFreeRangeEnd   netip.Addr
+	LargestFreeRangeSize  int64
+}
+
+// get the utilization of an already fetched ipblock from its direct children
+func (c *Client) GetIpBlockUtilization(ipblock models.IpBlock) (IpBlockUtilization, error) {
+	childQuery := NewIpBlockQueryBuilder()
+	childQuery.ParentID(ipblock.ID)
+	children, err := c.ListIpBlocks(childQuery.Build())
+	if err != nil {
+		return IpBlockUtilization{}, fmt.Errorf("Error reading IP block children: %s", err.Error())
+	}
+
+	return ComputeIpBlockUtilization(ipblock, children)
+}
+
+// compute the utilization of an ipblock from an already fetched list of its direct children.
+// Subnets only count the addresses that can be handed out from them, the same range the available
+// addresses are picked from.
+func ComputeIpBlockUtilization(ipblock models.IpBlock, children []models.IpBlock) (IpBlockUtilization, error) {
+	prefix, err := IpBlockPrefix(ipblock)
+	if err != nil {
+		return IpBlockUtilization{}, fmt.Errorf("Error parsing IP block address: %s", err.Error())
+	}
+	prefix = prefix.Masked()
+
+	first, last := prefix.Addr(), lastAddress(prefix)
+	if ipblock.Status == "Subnet" && !isHostIpBlock(ipblock) {
+		var ok bool
+		first, last, ok = usableAddressRange(prefix)
+		if !ok {
+			first, last = netip.Addr{}, netip.Addr{}
+		}
+	}
+
+	utilization := IpBlockUtilization{
+		StatusCounts: map[string]int64{
+			"Static":    0,
+			"Dhcp":      0,
+			"Reserved":  0,
+			"Available": 0,
+		},
+	}
+
+	total := new(big.Int)
+	if first.IsValid() {
+		total = addressCount(first, last)
+	}
+	used := new(big.Int)
+	covered := new(big.Int)
+
+	type addressRange struct {
+		start netip.Addr
+		end   netip.Addr
+	}
+	var usedRanges []addressRange
+
+	for _, child := range children {
+		if !first.IsValid() {
+			break
+		}
+
+		childPrefix, err := IpBlockPrefix(child)
+		if err != nil {
+			return IpBlockUtilization{}, fmt.Errorf("Error parsing IP block %d address: %s", child.ID, err.Error())
+		}
+		childPrefix = childPrefix.Masked()
+
+		start, end := childPrefix.Addr(), lastAddress(childPrefix)
+		if start.Less(first) {
+			start = first
+		}
+		if last.Less(end) {
+			end = last
+		}
+		if end.Less(start) {
+			continue
+		}
+
+		size := addressCount(start, end)
+		utilization.StatusCounts[child.Status] += saturatedInt64(size)
+		covered.Add(covered, size)
+
+		if child.Status == "Available" {
+			continue
+		}
+
+		used.Add(used, size)
+		usedRanges = append(usedRanges, addressRange{start: start, end: end})
+	}
+
+	free := new(big.Int).Sub(total, used)
+	unallocated := new(big.Int).Sub(total, covered)
+
+	utilization.Total = saturatedInt64(total)
+	utilization.Used = saturatedInt64(used)
+	utilization.Free = saturatedInt64(free)
+	utilization.Unallocated = saturatedInt64(unallocated)
+
+	if total.Sign() > 0 {
+		percent, _ := new(big.Float).Quo(new(big.Float).SetInt(used), new(big.Float).SetInt(total)).Float64()
+		utilization.PercentUsed = percent * 100
+	}
+
+	sort.Slice(usedRanges, func(i, j int) bool {
+		return usedRanges[i].start.Less(usedRanges[j].start)
+	})
+
+	largest := new(big.Int)
+	considerGap := func(start, end netip.Addr) {
+		if !start.IsValid() || !end.IsValid() || end.Less(start) {
+			return
+		}
+		size := addressCount(start, end)
+		if size.Cmp(largest) > 0 {
+			largest = size
+			utilization.LargestFreeRangeStart = start
+			utilization.LargestFreeRangeEnd = end
+		}
+	}
+
+	if !first.IsValid() {
+		return utilization, nil
+	}
+
+	cursor := first
+	for _, usedRange := range usedRanges {
+		if cursor.IsValid() && cursor.Less(usedRange.start) {
+			considerGap(cursor, usedRange.start.Prev())
+		}
+		if next := usedRange.end.Next(); !cursor.IsValid() || cursor.Less(next) {
+			cursor = next
+		}
+		if !cursor.IsValid() {
+			break
+		}
+	}
+	if cursor.IsValid() && !last.Less(cursor) {
+		considerGap(cursor, last)
+	}
+	utilization.LargestFreeRangeSize = saturatedInt64(largest)
+
+	return utilization, nil
+}
@@ -0,0 +1,46 @@
+package netdot
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+// get every status an ipblock can have, the list is fetched once per client
+func (c *Client) GetIpBlockStatuses() ([]models.IpBlockStatus, error) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.ipBlockStatuses != nil {
+		return c.ipBlockStatuses, nil
+	}
+
+	var results struct {
+		IpBlockStatuses []models.IpBlockStatus `xml:"IpblockStatus"`
+	}
+	_, err := c.Get("/rest/ipblockstatus", &results)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IP block statuses: %s", err.Error())
+	}
+
+	c.ipBlockStatuses = results.IpBlockStatuses
+	return c.ipBlockStatuses, nil
+}
+
+// check a status name against the statuses known to netdot
+func (c *Client) ValidateIpBlockStatus(status string) error {
+	statuses, err := c.GetIpBlockStatuses()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(statuses))
+	for _, known := range statuses {
+		if known.Name == status {
+			return nil
+		}
+		names = append(names, known.Name)
+	}
+
+	return fmt.Errorf("unknown IP block status %q, netdot knows %s", status, strings.Join(names, ", "))
+}
@@ -0,0 +1,35 @@
+package models
+
+import "encoding/xml"
+
+// <RRCAA id="71" flags="0" rr="uoregon.edu" rr_xlink="RR/999302" tag="issue" ttl="3600" value="letsencrypt.org"/>
+
+type RRCaa struct {
+	ID            int64  `xml:"id,attr"`
+	Flags         int64  `xml:"flags,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	Tag           string `xml:"tag,attr"`
+	TTL           int64  `xml:"ttl,attr"`
+	Value         string `xml:"value,attr"`
+}
+
+// XML Unmarshaler for RRCaa
+func (r *RRCaa) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRCaa RRCaa
+	finalRRCaa := bufferRRCaa{}
+	err := d.DecodeElement(&finalRRCaa, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRCaa.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRCaa.RRXlink = rr_xlink
+
+	*r = RRCaa(finalRRCaa)
+	return nil
+}
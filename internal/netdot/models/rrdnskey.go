@@ -0,0 +1,36 @@
+package models
+
+import "encoding/xml"
+
+// <RRDNSKEY id="12" algorithm="13" flags="257" protocol="3" public_key="mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==" rr="sub.uoregon.edu" rr_xlink="RR/999304" ttl="3600"/>
+
+type RRDnskey struct {
+	ID            int64  `xml:"id,attr"`
+	Algorithm     int64  `xml:"algorithm,attr"`
+	Flags         int64  `xml:"flags,attr"`
+	Protocol      int64  `xml:"protocol,attr"`
+	PublicKey     string `xml:"public_key,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	TTL           int64 `xml:"ttl,attr"`
+}
+
+// XML Unmarshaler for RRDnskey
+func (r *RRDnskey) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRDnskey RRDnskey
+	finalRRDnskey := bufferRRDnskey{}
+	err := d.DecodeElement(&finalRRDnskey, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRDnskey.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRDnskey.RRXlink = rr_xlink
+
+	*r = RRDnskey(finalRRDnskey)
+	return nil
+}
@@ -0,0 +1,36 @@
+package models
+
+import "encoding/xml"
+
+// <RRDS id="24" algorithm="13" digest="2BB183AF5F22588179A53B0A98631FAD1A292118" digest_type="2" key_tag="60485" rr="sub.uoregon.edu" rr_xlink="RR/999304" ttl="3600"/>
+
+type RRDs struct {
+	ID            int64  `xml:"id,attr"`
+	Algorithm     int64  `xml:"algorithm,attr"`
+	Digest        string `xml:"digest,attr"`
+	DigestType    int64  `xml:"digest_type,attr"`
+	KeyTag        int64  `xml:"key_tag,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	TTL           int64 `xml:"ttl,attr"`
+}
+
+// XML Unmarshaler for RRDs
+func (r *RRDs) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRDs RRDs
+	finalRRDs := bufferRRDs{}
+	err := d.DecodeElement(&finalRRDs, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRDs.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRDs.RRXlink = rr_xlink
+
+	*r = RRDs(finalRRDs)
+	return nil
+}
@@ -0,0 +1,34 @@
+package models
+
+import "encoding/xml"
+
+// <RRMX id="1201" exchange="mx1.uoregon.edu" preference="10" rr="cs.uoregon.edu" rr_xlink="RR/999301" ttl="3600"/>
+
+type RRMx struct {
+	ID            int64  `xml:"id,attr"`
+	Exchange      string `xml:"exchange,attr"`
+	Preference    int64  `xml:"preference,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	TTL           int64 `xml:"ttl,attr"`
+}
+
+// XML Unmarshaler for RRMx
+func (r *RRMx) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRMx RRMx
+	finalRRMx := bufferRRMx{}
+	err := d.DecodeElement(&finalRRMx, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRMx.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRMx.RRXlink = rr_xlink
+
+	*r = RRMx(finalRRMx)
+	return nil
+}
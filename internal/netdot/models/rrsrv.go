@@ -0,0 +1,36 @@
+package models
+
+import "encoding/xml"
+
+// <RRSRV id="812" port="88" priority="0" rr="_kerberos._udp.uoregon.edu" rr_xlink="RR/999303" target="kdc1.uoregon.edu" ttl="3600" weight="100"/>
+
+type RRSrv struct {
+	ID            int64  `xml:"id,attr"`
+	Port          int64  `xml:"port,attr"`
+	Priority      int64  `xml:"priority,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	Target        string `xml:"target,attr"`
+	TTL           int64  `xml:"ttl,attr"`
+	Weight        int64  `xml:"weight,attr"`
+}
+
+// XML Unmarshaler for RRSrv
+func (r *RRSrv) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRSrv RRSrv
+	finalRRSrv := bufferRRSrv{}
+	err := d.DecodeElement(&finalRRSrv, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRSrv.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRSrv.RRXlink = rr_xlink
+
+	*r = RRSrv(finalRRSrv)
+	return nil
+}
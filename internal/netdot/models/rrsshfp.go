@@ -0,0 +1,35 @@
+package models
+
+import "encoding/xml"
+
+// <RRSSHFP id="455" algorithm="4" fingerprint="e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" fp_type="2" rr="host1.uoregon.edu" rr_xlink="RR/999289" ttl="3600"/>
+
+type RRSshfp struct {
+	ID            int64  `xml:"id,attr"`
+	Algorithm     int64  `xml:"algorithm,attr"`
+	Fingerprint   string `xml:"fingerprint,attr"`
+	FPType        int64  `xml:"fp_type,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	TTL           int64 `xml:"ttl,attr"`
+}
+
+// XML Unmarshaler for RRSshfp
+func (r *RRSshfp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRSshfp RRSshfp
+	finalRRSshfp := bufferRRSshfp{}
+	err := d.DecodeElement(&finalRRSshfp, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRSshfp.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRSshfp.RRXlink = rr_xlink
+
+	*r = RRSshfp(finalRRSshfp)
+	return nil
+}
@@ -0,0 +1,33 @@
+package models
+
+import "encoding/xml"
+
+// <RRTXT id="3021" rr="uoregon.edu" rr_xlink="RR/999302" ttl="3600" txtdata="&quot;v=spf1 mx -all&quot;"/>
+
+type RRTxt struct {
+	ID            int64  `xml:"id,attr"`
+	RR            string `xml:"rr,attr"`
+	RRXLinkString string `xml:"rr_xlink,attr"`
+	RRXlink       Xlink
+	TTL           int64  `xml:"ttl,attr"`
+	TxtData       string `xml:"txtdata,attr"`
+}
+
+// XML Unmarshaler for RRTxt
+func (r *RRTxt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferRRTxt RRTxt
+	finalRRTxt := bufferRRTxt{}
+	err := d.DecodeElement(&finalRRTxt, &start)
+	if err != nil {
+		return err
+	}
+
+	rr_xlink, err := parseXlink(finalRRTxt.RRXLinkString)
+	if err != nil {
+		return err
+	}
+	finalRRTxt.RRXlink = rr_xlink
+
+	*r = RRTxt(finalRRTxt)
+	return nil
+}
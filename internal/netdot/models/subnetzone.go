@@ -0,0 +1,40 @@
+package models
+
+import "encoding/xml"
+
+// <SubnetZone id="42" subnet="184.171.15.0/24" subnet_xlink="Ipblock/2711826800" zone="15.171.184.in-addr.arpa" zone_xlink="Zone/310"/>
+
+type SubnetZone struct {
+	ID                int64  `xml:"id,attr"`
+	Subnet            string `xml:"subnet,attr"`
+	SubnetXLinkString string `xml:"subnet_xlink,attr"`
+	SubnetXlink       Xlink
+	Zone              string `xml:"zone,attr"`
+	ZoneXLinkString   string `xml:"zone_xlink,attr"`
+	ZoneXlink         Xlink
+}
+
+// XML Unmarshaler for SubnetZone
+func (r *SubnetZone) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bufferSubnetZone SubnetZone
+	finalSubnetZone := bufferSubnetZone{}
+	err := d.DecodeElement(&finalSubnetZone, &start)
+	if err != nil {
+		return err
+	}
+
+	subnet_xlink, err := parseXlink(finalSubnetZone.SubnetXLinkString)
+	if err != nil {
+		return err
+	}
+	finalSubnetZone.SubnetXlink = subnet_xlink
+
+	zone_xlink, err := parseXlink(finalSubnetZone.ZoneXLinkString)
+	if err != nil {
+		return err
+	}
+	finalSubnetZone.ZoneXlink = zone_xlink
+
+	*r = SubnetZone(finalSubnetZone)
+	return nil
+}
@@ -0,0 +1,19 @@
+package models
+
+// <Zone id="310" name="15.171.184.in-addr.arpa" active="1" contactlist="0" default_ttl="86400" expire="1209600" export_file="15.171.184.in-addr.arpa" include="" info="" minimum="3600" mname="ns1.uoregon.edu" refresh="3600" retry="900" rname="hostmaster.uoregon.edu" serial="2025032401"/>
+
+type Zone struct {
+	ID         int64  `xml:"id,attr"`
+	Name       string `xml:"name,attr"`
+	Active     bool   `xml:"active,attr"`
+	DefaultTTL int64  `xml:"default_ttl,attr"`
+	Expire     int64  `xml:"expire,attr"`
+	ExportFile string `xml:"export_file,attr"`
+	Info       string `xml:"info,attr"`
+	Minimum    int64  `xml:"minimum,attr"`
+	MName      string `xml:"mname,attr"`
+	Refresh    int64  `xml:"refresh,attr"`
+	Retry      int64  `xml:"retry,attr"`
+	RName      string `xml:"rname,attr"`
+	Serial     int64  `xml:"serial,attr"`
+}
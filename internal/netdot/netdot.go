@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"terraform-provider-netdot/internal/netdot/models"
 
 	"github.com/google/go-querystring/query"
 )
@@ -25,6 +27,10 @@ type Client struct {
 	username    string
 	password    string
 	auth_cookie *http.Cookie
+
+	// lookup tables that don't change while terraform runs, loaded on first use
+	cacheMutex      sync.Mutex
+	ipBlockStatuses []models.IpBlockStatus
 }
 
 type authPayload struct {
@@ -137,6 +143,27 @@ func (c *Client) GetResourceByID(resourceType string, id int64, resource any) (*
 	return httpStatusCode, nil
 }
 
+// generic search for resources of a type, unset query fields are left out of the request
+func (c *Client) Search(resourceType string, searchQuery any, results any) (*int, error) {
+	param_values, err := query.Values(searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range param_values {
+		if len(values) == 0 || values[0] == "" {
+			param_values.Del(key)
+		}
+	}
+
+	endpoint := fmt.Sprintf("/rest/%s", resourceType)
+	if len(param_values) > 0 {
+		endpoint = fmt.Sprintf("/rest/%s?%s", resourceType, param_values.Encode())
+	}
+
+	return c.Get(endpoint, results)
+}
+
 // generic delete resource type by id
 func (c *Client) DeleteResourceByID(resourceType string, id int64, optionalQuery any) error {
 	if id <= 0 {
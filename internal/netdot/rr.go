@@ -1,5 +1,12 @@
 package netdot
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
 type RRQuery struct {
 	Active     *int64  `url:"active"`
 	AutoUpdate *int64  `url:"auto_update"`
@@ -54,3 +61,115 @@ func (b *RRQueryBuilder) ZoneID(zoneID int64) *RRQueryBuilder {
 func (b *RRQueryBuilder) Build() RRQuery {
 	return b.query
 }
+
+type rrSearchResults struct {
+	RRs []models.RR `xml:"RR"`
+}
+
+// list every RR matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRs(q RRQuery) ([]models.RR, error) {
+	var results rrSearchResults
+	statusCode, err := c.Search("rr", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RR{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRs, nil
+}
+
+// NormalizeFQDN lowercases a domain name and drops the trailing root dot
+func NormalizeFQDN(fqdn string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(fqdn)), ".")
+}
+
+// ValidateHostname checks that name is a domain name made of letter, digit and hyphen labels (RFC 1123, section 2.1).
+// A trailing root dot is allowed.
+func ValidateHostname(name string) error {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" || len(trimmed) > 253 {
+		return fmt.Errorf("%q is not a valid hostname, it must be between 1 and 253 characters long", name)
+	}
+
+	for _, label := range strings.Split(trimmed, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return fmt.Errorf("%q is not a valid hostname, every label must be between 1 and 63 characters long", name)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("%q is not a valid hostname, labels must not start or end with a hyphen", name)
+		}
+		for i := 0; i < len(label); i++ {
+			ch := label[i]
+			if !(ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-') {
+				return fmt.Errorf("%q is not a valid hostname, %q is not a letter, digit or hyphen", name, ch)
+			}
+		}
+	}
+
+	return nil
+}
+
+// get the single RR whose name and zone make up fqdn
+func (c *Client) GetRRByFQDN(fqdn string) (models.RR, error) {
+	matches, err := c.findRRsByFQDN(fqdn)
+	if err != nil {
+		return models.RR{}, err
+	}
+
+	if len(matches) == 0 {
+		return models.RR{}, fmt.Errorf("no RR found for %s", NormalizeFQDN(fqdn))
+	}
+
+	if len(matches) > 1 {
+		return models.RR{}, fmt.Errorf("%d RRs found for %s", len(matches), NormalizeFQDN(fqdn))
+	}
+
+	return matches[0], nil
+}
+
+// name netdot stores the records at the apex of a zone under
+const zoneApexName = "@"
+
+// every RR whose name and zone make up fqdn.
+// Every suffix of fqdn is looked up as a netdot zone first, fqdn itself as the apex of a zone,
+// and only the zones that exist are searched for the rest of the name.
+func (c *Client) findRRsByFQDN(fqdn string) ([]models.RR, error) {
+	fqdn = NormalizeFQDN(fqdn)
+	labels := strings.Split(fqdn, ".")
+	if fqdn == "" || len(labels) < 2 {
+		return nil, fmt.Errorf("%q is not a fully qualified domain name", fqdn)
+	}
+
+	matches := []models.RR{}
+	for i := 0; i < len(labels); i++ {
+		name := zoneApexName
+		if i > 0 {
+			name = strings.Join(labels[:i], ".")
+		}
+
+		zones, err := c.listZonesNamed(strings.Join(labels[i:], "."))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zone := range zones {
+			queryBuilder := NewRRQueryBuilder()
+			queryBuilder.Name(name)
+			queryBuilder.ZoneID(zone.ID)
+			rrs, err := c.ListRRs(queryBuilder.Build())
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rr := range rrs {
+				if strings.EqualFold(rr.Name, name) && rr.ZoneXlink.ID == zone.ID {
+					matches = append(matches, rr)
+				}
+			}
+		}
+	}
+
+	return matches, nil
+}
@@ -1,5 +1,12 @@
 package netdot
 
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
 type RRAddrQuery struct {
 	IpBlock        *int64 `url:"ipblock"`
 	RR             *int64 `url:"rr"`
@@ -52,3 +59,85 @@ func (b *RRAddrQueryBuilder) TTL(ttl int64) *RRAddrQueryBuilder {
 func (b *RRAddrQueryBuilder) Build() RRAddrQuery {
 	return b.query
 }
+
+type rrAddrSearchResults struct {
+	RRAddrs []models.RRAddr `xml:"RRADDR"`
+}
+
+// list every A/AAAA record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRAddrs(q RRAddrQuery) ([]models.RRAddr, error) {
+	var results rrAddrSearchResults
+	statusCode, err := c.Search("rraddr", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRAddr{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRAddrs, nil
+}
+
+// get the single A/AAAA record pointing fqdn at addr
+func (c *Client) GetRRAddrByFQDN(fqdn string, addr netip.Addr) (models.RRAddr, error) {
+	rr, err := c.GetRRByFQDN(fqdn)
+	if err != nil {
+		return models.RRAddr{}, err
+	}
+
+	queryBuilder := NewRRAddrQueryBuilder()
+	queryBuilder.RR(rr.ID)
+	rraddrs, err := c.ListRRAddrs(queryBuilder.Build())
+	if err != nil {
+		return models.RRAddr{}, err
+	}
+
+	matches := []models.RRAddr{}
+	for _, rraddr := range rraddrs {
+		prefix, _, err := ParseAddressOrPrefix(rraddr.IpBlock)
+		if err != nil {
+			continue
+		}
+		if prefix.Addr() == addr.Unmap() {
+			matches = append(matches, rraddr)
+		}
+	}
+
+	if len(matches) == 0 {
+		return models.RRAddr{}, fmt.Errorf("no address record found for %s with address %s", NormalizeFQDN(fqdn), addr)
+	}
+
+	if len(matches) > 1 {
+		return models.RRAddr{}, fmt.Errorf("%d address records found for %s with address %s", len(matches), NormalizeFQDN(fqdn), addr)
+	}
+
+	return matches[0], nil
+}
+
+// AddressRecordType is the DNS type of an address record for addr, A for IPv4 and AAAA for IPv6
+func AddressRecordType(addr netip.Addr) string {
+	if addr.Unmap().Is4() {
+		return "A"
+	}
+	return "AAAA"
+}
+
+// AddressRecordTypeForIpBlock is the DNS type of an address record pointing at ipblock. The ipblock must be a
+// single address, records cannot point at a subnet.
+func AddressRecordTypeForIpBlock(ipblock models.IpBlock) (string, error) {
+	prefix, err := IpBlockPrefix(ipblock)
+	if err != nil {
+		return "", err
+	}
+
+	if prefix.Bits() != prefix.Addr().BitLen() {
+		return "", fmt.Errorf("ipblock %s is not a single address", prefix)
+	}
+
+	recordType := AddressRecordType(prefix.Addr())
+	if (ipblock.Version == 4 && recordType != "A") || (ipblock.Version == 6 && recordType != "AAAA") {
+		return "", fmt.Errorf("ipblock %s has version %d, which does not match its address", prefix, ipblock.Version)
+	}
+
+	return recordType, nil
+}
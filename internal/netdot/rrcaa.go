@@ -0,0 +1,59 @@
+package netdot
+
+type RRCaaQuery struct {
+	Flags          *int64  `url:"flags"`
+	RR             *int64  `url:"rr"`
+	Tag            *string `url:"tag"`
+	TTL            *int64  `url:"ttl"`
+	Value          *string `url:"value"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRCaaQuery) Builder() RRCaaQueryBuilder {
+	return RRCaaQueryBuilder{query: q}
+}
+
+type RRCaaQueryBuilder struct {
+	query RRCaaQuery
+}
+
+func NewRRCaaQueryBuilder() *RRCaaQueryBuilder {
+	return &RRCaaQueryBuilder{
+		query: RRCaaQuery{},
+	}
+}
+
+func (b *RRCaaQueryBuilder) SkipDeletingRR(skip bool) *RRCaaQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRCaaQueryBuilder) Flags(flags int64) *RRCaaQueryBuilder {
+	b.query.Flags = &flags
+	return b
+}
+
+func (b *RRCaaQueryBuilder) RR(rr int64) *RRCaaQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRCaaQueryBuilder) Tag(tag string) *RRCaaQueryBuilder {
+	b.query.Tag = &tag
+	return b
+}
+
+func (b *RRCaaQueryBuilder) TTL(ttl int64) *RRCaaQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRCaaQueryBuilder) Value(value string) *RRCaaQueryBuilder {
+	b.query.Value = &value
+	return b
+}
+
+func (b *RRCaaQueryBuilder) Build() RRCaaQuery {
+	return b.query
+}
@@ -1,5 +1,12 @@
 package netdot
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
 type RRCnameQuery struct {
 	Cname          *string `url:"cname"`
 	RR             *int64  `url:"rr"`
@@ -45,3 +52,73 @@ func (b *RRCnameQueryBuilder) TTL(ttl int64) *RRCnameQueryBuilder {
 func (b *RRCnameQueryBuilder) Build() RRCnameQuery {
 	return b.query
 }
+
+type rrCnameSearchResults struct {
+	RRCnames []models.RRCname `xml:"RRCNAME"`
+}
+
+// list every CNAME record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRCnames(q RRCnameQuery) ([]models.RRCname, error) {
+	var results rrCnameSearchResults
+	statusCode, err := c.Search("rrcname", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRCname{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRCnames, nil
+}
+
+// get the single CNAME record of fqdn
+func (c *Client) GetRRCnameByFQDN(fqdn string) (models.RRCname, error) {
+	rr, err := c.GetRRByFQDN(fqdn)
+	if err != nil {
+		return models.RRCname{}, err
+	}
+
+	queryBuilder := NewRRCnameQueryBuilder()
+	queryBuilder.RR(rr.ID)
+	cnames, err := c.ListRRCnames(queryBuilder.Build())
+	if err != nil {
+		return models.RRCname{}, err
+	}
+
+	if len(cnames) == 0 {
+		return models.RRCname{}, fmt.Errorf("no CNAME record found for %s", NormalizeFQDN(fqdn))
+	}
+
+	if len(cnames) > 1 {
+		return models.RRCname{}, fmt.Errorf("%d CNAME records found for %s", len(cnames), NormalizeFQDN(fqdn))
+	}
+
+	return cnames[0], nil
+}
+
+// whether fqdn is an alias, i.e. netdot has a CNAME record for it. Names netdot does not know are not aliases,
+// neither are names outside of every netdot zone or the root of a null MX (RFC 7505).
+func (c *Client) IsCNAME(fqdn string) (bool, error) {
+	if !strings.Contains(NormalizeFQDN(fqdn), ".") {
+		return false, nil
+	}
+
+	rrs, err := c.findRRsByFQDN(fqdn)
+	if err != nil {
+		return false, err
+	}
+
+	for _, rr := range rrs {
+		queryBuilder := NewRRCnameQueryBuilder()
+		queryBuilder.RR(rr.ID)
+		cnames, err := c.ListRRCnames(queryBuilder.Build())
+		if err != nil {
+			return false, err
+		}
+		if len(cnames) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,65 @@
+package netdot
+
+type RRDnskeyQuery struct {
+	Algorithm      *int64  `url:"algorithm"`
+	Flags          *int64  `url:"flags"`
+	Protocol       *int64  `url:"protocol"`
+	PublicKey      *string `url:"public_key"`
+	RR             *int64  `url:"rr"`
+	TTL            *int64  `url:"ttl"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRDnskeyQuery) Builder() RRDnskeyQueryBuilder {
+	return RRDnskeyQueryBuilder{query: q}
+}
+
+type RRDnskeyQueryBuilder struct {
+	query RRDnskeyQuery
+}
+
+func NewRRDnskeyQueryBuilder() *RRDnskeyQueryBuilder {
+	return &RRDnskeyQueryBuilder{
+		query: RRDnskeyQuery{},
+	}
+}
+
+func (b *RRDnskeyQueryBuilder) SkipDeletingRR(skip bool) *RRDnskeyQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) Algorithm(algorithm int64) *RRDnskeyQueryBuilder {
+	b.query.Algorithm = &algorithm
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) Flags(flags int64) *RRDnskeyQueryBuilder {
+	b.query.Flags = &flags
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) Protocol(protocol int64) *RRDnskeyQueryBuilder {
+	b.query.Protocol = &protocol
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) PublicKey(publicKey string) *RRDnskeyQueryBuilder {
+	b.query.PublicKey = &publicKey
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) RR(rr int64) *RRDnskeyQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) TTL(ttl int64) *RRDnskeyQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRDnskeyQueryBuilder) Build() RRDnskeyQuery {
+	return b.query
+}
@@ -0,0 +1,65 @@
+package netdot
+
+type RRDsQuery struct {
+	Algorithm      *int64  `url:"algorithm"`
+	Digest         *string `url:"digest"`
+	DigestType     *int64  `url:"digest_type"`
+	KeyTag         *int64  `url:"key_tag"`
+	RR             *int64  `url:"rr"`
+	TTL            *int64  `url:"ttl"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRDsQuery) Builder() RRDsQueryBuilder {
+	return RRDsQueryBuilder{query: q}
+}
+
+type RRDsQueryBuilder struct {
+	query RRDsQuery
+}
+
+func NewRRDsQueryBuilder() *RRDsQueryBuilder {
+	return &RRDsQueryBuilder{
+		query: RRDsQuery{},
+	}
+}
+
+func (b *RRDsQueryBuilder) SkipDeletingRR(skip bool) *RRDsQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRDsQueryBuilder) Algorithm(algorithm int64) *RRDsQueryBuilder {
+	b.query.Algorithm = &algorithm
+	return b
+}
+
+func (b *RRDsQueryBuilder) Digest(digest string) *RRDsQueryBuilder {
+	b.query.Digest = &digest
+	return b
+}
+
+func (b *RRDsQueryBuilder) DigestType(digestType int64) *RRDsQueryBuilder {
+	b.query.DigestType = &digestType
+	return b
+}
+
+func (b *RRDsQueryBuilder) KeyTag(keyTag int64) *RRDsQueryBuilder {
+	b.query.KeyTag = &keyTag
+	return b
+}
+
+func (b *RRDsQueryBuilder) RR(rr int64) *RRDsQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRDsQueryBuilder) TTL(ttl int64) *RRDsQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRDsQueryBuilder) Build() RRDsQuery {
+	return b.query
+}
@@ -0,0 +1,109 @@
+package netdot
+
+import (
+	"fmt"
+	"net/http"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+type RRMxQuery struct {
+	Exchange       *string `url:"exchange"`
+	Preference     *int64  `url:"preference"`
+	RR             *int64  `url:"rr"`
+	TTL            *int64  `url:"ttl"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRMxQuery) Builder() RRMxQueryBuilder {
+	return RRMxQueryBuilder{query: q}
+}
+
+type RRMxQueryBuilder struct {
+	query RRMxQuery
+}
+
+func NewRRMxQueryBuilder() *RRMxQueryBuilder {
+	return &RRMxQueryBuilder{
+		query: RRMxQuery{},
+	}
+}
+
+func (b *RRMxQueryBuilder) SkipDeletingRR(skip bool) *RRMxQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRMxQueryBuilder) Exchange(exchange string) *RRMxQueryBuilder {
+	b.query.Exchange = &exchange
+	return b
+}
+
+func (b *RRMxQueryBuilder) Preference(preference int64) *RRMxQueryBuilder {
+	b.query.Preference = &preference
+	return b
+}
+
+func (b *RRMxQueryBuilder) RR(rr int64) *RRMxQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRMxQueryBuilder) TTL(ttl int64) *RRMxQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRMxQueryBuilder) Build() RRMxQuery {
+	return b.query
+}
+
+type rrMxSearchResults struct {
+	RRMxs []models.RRMx `xml:"RRMX"`
+}
+
+// list every MX record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRMxs(q RRMxQuery) ([]models.RRMx, error) {
+	var results rrMxSearchResults
+	statusCode, err := c.Search("rrmx", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRMx{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRMxs, nil
+}
+
+// get the single MX record of fqdn pointing at exchange
+func (c *Client) GetRRMxByFQDN(fqdn string, exchange string) (models.RRMx, error) {
+	rr, err := c.GetRRByFQDN(fqdn)
+	if err != nil {
+		return models.RRMx{}, err
+	}
+
+	queryBuilder := NewRRMxQueryBuilder()
+	queryBuilder.RR(rr.ID)
+	mxs, err := c.ListRRMxs(queryBuilder.Build())
+	if err != nil {
+		return models.RRMx{}, err
+	}
+
+	matches := []models.RRMx{}
+	for _, mx := range mxs {
+		if NormalizeFQDN(mx.Exchange) == NormalizeFQDN(exchange) {
+			matches = append(matches, mx)
+		}
+	}
+
+	if len(matches) == 0 {
+		return models.RRMx{}, fmt.Errorf("no MX record found for %s with exchange %s", NormalizeFQDN(fqdn), NormalizeFQDN(exchange))
+	}
+
+	if len(matches) > 1 {
+		return models.RRMx{}, fmt.Errorf("%d MX records found for %s with exchange %s", len(matches), NormalizeFQDN(fqdn), NormalizeFQDN(exchange))
+	}
+
+	return matches[0], nil
+}
@@ -1,5 +1,11 @@
 package netdot
 
+import (
+	"fmt"
+	"net/http"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
 type RRNsQuery struct {
 	NsDName *string `url:"nsdname"`
 	RR      *int64  `url:"rr"`
@@ -38,3 +44,53 @@ func (b *RRNsQueryBuilder) TTL(ttl int64) *RRNsQueryBuilder {
 func (b *RRNsQueryBuilder) Build() RRNsQuery {
 	return b.query
 }
+
+type rrNsSearchResults struct {
+	RRNss []models.RRNs `xml:"RRNS"`
+}
+
+// list every NS record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRNss(q RRNsQuery) ([]models.RRNs, error) {
+	var results rrNsSearchResults
+	statusCode, err := c.Search("rrns", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRNs{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRNss, nil
+}
+
+// get the single NS record delegating fqdn to nsdname
+func (c *Client) GetRRNsByFQDN(fqdn string, nsdname string) (models.RRNs, error) {
+	rr, err := c.GetRRByFQDN(fqdn)
+	if err != nil {
+		return models.RRNs{}, err
+	}
+
+	queryBuilder := NewRRNsQueryBuilder()
+	queryBuilder.RR(rr.ID)
+	nss, err := c.ListRRNss(queryBuilder.Build())
+	if err != nil {
+		return models.RRNs{}, err
+	}
+
+	matches := []models.RRNs{}
+	for _, ns := range nss {
+		if NormalizeFQDN(ns.NsDName) == NormalizeFQDN(nsdname) {
+			matches = append(matches, ns)
+		}
+	}
+
+	if len(matches) == 0 {
+		return models.RRNs{}, fmt.Errorf("no NS record found for %s with nsdname %s", NormalizeFQDN(fqdn), NormalizeFQDN(nsdname))
+	}
+
+	if len(matches) > 1 {
+		return models.RRNs{}, fmt.Errorf("%d NS records found for %s with nsdname %s", len(matches), NormalizeFQDN(fqdn), NormalizeFQDN(nsdname))
+	}
+
+	return matches[0], nil
+}
@@ -0,0 +1,254 @@
+package netdot
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+type RRPtrQuery struct {
+	IpBlock  *int64  `url:"ipblock"`
+	PTRdname *string `url:"ptrdname"`
+	RR       *int64  `url:"rr"`
+	TTL      *int64  `url:"ttl"`
+
+	NoChangeStatus *int64 `url:"no_change_status,omitempty"`
+}
+
+func (q RRPtrQuery) Builder() RRPtrQueryBuilder {
+	return RRPtrQueryBuilder{query: q}
+}
+
+type RRPtrQueryBuilder struct {
+	query RRPtrQuery
+}
+
+func NewRRPtrQueryBuilder() *RRPtrQueryBuilder {
+	return &RRPtrQueryBuilder{
+		query: RRPtrQuery{},
+	}
+}
+
+func (b *RRPtrQueryBuilder) NoChangeStatus(skip bool) *RRPtrQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.NoChangeStatus = &boolInt
+	return b
+}
+
+func (b *RRPtrQueryBuilder) IpBlock(ipBlock int64) *RRPtrQueryBuilder {
+	b.query.IpBlock = &ipBlock
+	return b
+}
+
+func (b *RRPtrQueryBuilder) PTRdname(ptrdname string) *RRPtrQueryBuilder {
+	b.query.PTRdname = &ptrdname
+	return b
+}
+
+func (b *RRPtrQueryBuilder) RR(rr int64) *RRPtrQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRPtrQueryBuilder) TTL(ttl int64) *RRPtrQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRPtrQueryBuilder) Build() RRPtrQuery {
+	return b.query
+}
+
+type rrPtrSearchResults struct {
+	RRPtrs []models.RRPtr `xml:"RRPTR"`
+}
+
+// list every PTR record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRPtrs(q RRPtrQuery) ([]models.RRPtr, error) {
+	var results rrPtrSearchResults
+	statusCode, err := c.Search("rrptr", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRPtr{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRPtrs, nil
+}
+
+// get the single PTR record of an address
+func (c *Client) GetRRPtrByAddress(addr netip.Addr) (models.RRPtr, error) {
+	ipblock, err := c.GetIpBlockByPrefix(netip.PrefixFrom(addr, addr.BitLen()))
+	if err != nil {
+		return models.RRPtr{}, err
+	}
+
+	queryBuilder := NewRRPtrQueryBuilder()
+	queryBuilder.IpBlock(ipblock.ID)
+	ptrs, err := c.ListRRPtrs(queryBuilder.Build())
+	if err != nil {
+		return models.RRPtr{}, err
+	}
+
+	if len(ptrs) == 0 {
+		return models.RRPtr{}, fmt.Errorf("no PTR record found for %s", addr)
+	}
+
+	if len(ptrs) > 1 {
+		return models.RRPtr{}, fmt.Errorf("%d PTR records found for %s", len(ptrs), addr)
+	}
+
+	return ptrs[0], nil
+}
+
+// PTRName returns the full in-addr.arpa or ip6.arpa name of an address
+func PTRName(addr netip.Addr) string {
+	addr = addr.Unmap()
+	return ReverseZoneName(netip.PrefixFrom(addr, addr.BitLen()))
+}
+
+// find the most specific reverse zone on the server that holds the PTR record of addr
+func (c *Client) FindReverseZone(addr netip.Addr) (models.Zone, error) {
+	addr = addr.Unmap()
+
+	step := 4
+	if addr.Is4() {
+		step = 8
+	}
+
+	for bits := addr.BitLen() - step; bits >= 0; bits -= step {
+		name := ReverseZoneName(netip.PrefixFrom(addr, bits))
+
+		queryBuilder := NewZoneQueryBuilder()
+		queryBuilder.Name(name)
+		zones, err := c.ListZones(queryBuilder.Build())
+		if err != nil {
+			return models.Zone{}, err
+		}
+
+		for _, zone := range zones {
+			if NormalizeFQDN(zone.Name) == name {
+				return zone, nil
+			}
+		}
+	}
+
+	return models.Zone{}, fmt.Errorf("no reverse zone found for %s, create one with netdot_zone or create_reverse_zone on the subnet", addr)
+}
+
+// find or create the RR that owns the PTR record of addr, inside its reverse zone
+func (c *Client) EnsurePTRRR(addr netip.Addr) (models.RR, error) {
+	zone, err := c.FindReverseZone(addr)
+	if err != nil {
+		return models.RR{}, err
+	}
+
+	name := strings.TrimSuffix(PTRName(addr), "."+NormalizeFQDN(zone.Name))
+
+	queryBuilder := NewRRQueryBuilder()
+	queryBuilder.Name(name)
+	queryBuilder.ZoneID(zone.ID)
+
+	rrs, err := c.ListRRs(queryBuilder.Build())
+	if err != nil {
+		return models.RR{}, err
+	}
+
+	for _, rr := range rrs {
+		if strings.EqualFold(rr.Name, name) && rr.ZoneXlink.ID == zone.ID {
+			return rr, nil
+		}
+	}
+
+	queryBuilder.Active(true)
+	queryBuilder.AutoUpdate(false)
+
+	var rr models.RR
+	err = c.CreateResource("rr", queryBuilder.Build(), &rr)
+	if err != nil {
+		return models.RR{}, err
+	}
+
+	return rr, nil
+}
+
+// point the PTR record of an ipblock at ptrdname. ptrID is the PTR managed so far, it is updated in place
+// while it still belongs to the ipblock and replaced otherwise. Without one, an existing PTR of the
+// address with the same name is taken over before a new one is created, the returned bool reports such a takeover.
+func (c *Client) SetRRPtr(ptrID *int64, ipblockID int64, ptrdname string, ttl int64) (models.RRPtr, bool, error) {
+	queryBuilder := NewRRPtrQueryBuilder()
+	queryBuilder.IpBlock(ipblockID)
+	queryBuilder.PTRdname(ptrdname)
+	queryBuilder.TTL(ttl)
+
+	if ptrID != nil {
+		var current models.RRPtr
+		statusCode, err := c.GetResourceByID("rrptr", *ptrID, &current)
+		if err != nil && (statusCode == nil || *statusCode != http.StatusNotFound) {
+			return models.RRPtr{}, false, err
+		}
+
+		if err == nil {
+			if current.IpBlockXlink.ID == ipblockID {
+				queryBuilder.RR(current.RRXlink.ID)
+				var updated models.RRPtr
+				err = c.UpdateResource("rrptr", current.ID, queryBuilder.Build(), &updated)
+				return updated, false, err
+			}
+
+			err = c.DeleteRRPtr(current.ID)
+			if err != nil {
+				return models.RRPtr{}, false, err
+			}
+		}
+	}
+
+	existingQuery := NewRRPtrQueryBuilder()
+	existingQuery.IpBlock(ipblockID)
+	existing, err := c.ListRRPtrs(existingQuery.Build())
+	if err != nil {
+		return models.RRPtr{}, false, err
+	}
+
+	for _, ptr := range existing {
+		if NormalizeFQDN(ptr.PTRdname) == NormalizeFQDN(ptrdname) {
+			queryBuilder.RR(ptr.RRXlink.ID)
+			var updated models.RRPtr
+			err = c.UpdateResource("rrptr", ptr.ID, queryBuilder.Build(), &updated)
+			return updated, true, err
+		}
+	}
+
+	var ipblock models.IpBlock
+	_, err = c.GetResourceByID("ipblock", ipblockID, &ipblock)
+	if err != nil {
+		return models.RRPtr{}, false, err
+	}
+
+	addr, err := netip.ParseAddr(ipblock.Address)
+	if err != nil {
+		return models.RRPtr{}, false, err
+	}
+
+	rr, err := c.EnsurePTRRR(addr)
+	if err != nil {
+		return models.RRPtr{}, false, err
+	}
+	queryBuilder.RR(rr.ID)
+
+	var created models.RRPtr
+	err = c.CreateResource("rrptr", queryBuilder.Build(), &created)
+	return created, false, err
+}
+
+// delete a PTR record the way A records are deleted, leaving the status of the ipblock alone.
+// The in-addr.arpa or ip6.arpa RR only exists for the PTR, so netdot may remove it along with it.
+func (c *Client) DeleteRRPtr(id int64) error {
+	queryBuilder := NewRRPtrQueryBuilder()
+	queryBuilder.NoChangeStatus(true)
+
+	return c.DeleteResourceByID("rrptr", id, queryBuilder.Build())
+}
@@ -0,0 +1,107 @@
+package netdot
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+type RRSrvQuery struct {
+	Port           *int64  `url:"port"`
+	Priority       *int64  `url:"priority"`
+	RR             *int64  `url:"rr"`
+	Target         *string `url:"target"`
+	TTL            *int64  `url:"ttl"`
+	Weight         *int64  `url:"weight"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRSrvQuery) Builder() RRSrvQueryBuilder {
+	return RRSrvQueryBuilder{query: q}
+}
+
+type RRSrvQueryBuilder struct {
+	query RRSrvQuery
+}
+
+func NewRRSrvQueryBuilder() *RRSrvQueryBuilder {
+	return &RRSrvQueryBuilder{
+		query: RRSrvQuery{},
+	}
+}
+
+func (b *RRSrvQueryBuilder) SkipDeletingRR(skip bool) *RRSrvQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRSrvQueryBuilder) Port(port int64) *RRSrvQueryBuilder {
+	b.query.Port = &port
+	return b
+}
+
+func (b *RRSrvQueryBuilder) Priority(priority int64) *RRSrvQueryBuilder {
+	b.query.Priority = &priority
+	return b
+}
+
+func (b *RRSrvQueryBuilder) RR(rr int64) *RRSrvQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRSrvQueryBuilder) Target(target string) *RRSrvQueryBuilder {
+	b.query.Target = &target
+	return b
+}
+
+func (b *RRSrvQueryBuilder) TTL(ttl int64) *RRSrvQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRSrvQueryBuilder) Weight(weight int64) *RRSrvQueryBuilder {
+	b.query.Weight = &weight
+	return b
+}
+
+func (b *RRSrvQueryBuilder) Build() RRSrvQuery {
+	return b.query
+}
+
+type rrSrvSearchResults struct {
+	RRSrvs []models.RRSrv `xml:"RRSRV"`
+}
+
+// list every SRV record matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListRRSrvs(q RRSrvQuery) ([]models.RRSrv, error) {
+	var results rrSrvSearchResults
+	statusCode, err := c.Search("rrsrv", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.RRSrv{}, nil
+		}
+		return nil, err
+	}
+
+	return results.RRSrvs, nil
+}
+
+// ValidateSRVOwnerName checks that the name of the RR owning an SRV record starts with _service._proto (RFC 2782),
+// e.g. _ldap._tcp or _kerberos._udp.dc1
+func ValidateSRVOwnerName(name string) error {
+	labels := strings.Split(NormalizeFQDN(name), ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("%q is not a valid SRV owner name, it must start with _service._proto", name)
+	}
+
+	for _, label := range labels[:2] {
+		if len(label) < 2 || label[0] != '_' || ValidateHostname(label[1:]) != nil {
+			return fmt.Errorf("%q is not a valid SRV owner name, %q must be an underscore followed by letters, digits and hyphens", name, label)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package netdot
+
+type RRSshfpQuery struct {
+	Algorithm      *int64  `url:"algorithm"`
+	Fingerprint    *string `url:"fingerprint"`
+	FPType         *int64  `url:"fp_type"`
+	RR             *int64  `url:"rr"`
+	TTL            *int64  `url:"ttl"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRSshfpQuery) Builder() RRSshfpQueryBuilder {
+	return RRSshfpQueryBuilder{query: q}
+}
+
+type RRSshfpQueryBuilder struct {
+	query RRSshfpQuery
+}
+
+func NewRRSshfpQueryBuilder() *RRSshfpQueryBuilder {
+	return &RRSshfpQueryBuilder{
+		query: RRSshfpQuery{},
+	}
+}
+
+func (b *RRSshfpQueryBuilder) SkipDeletingRR(skip bool) *RRSshfpQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) Algorithm(algorithm int64) *RRSshfpQueryBuilder {
+	b.query.Algorithm = &algorithm
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) Fingerprint(fingerprint string) *RRSshfpQueryBuilder {
+	b.query.Fingerprint = &fingerprint
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) FPType(fpType int64) *RRSshfpQueryBuilder {
+	b.query.FPType = &fpType
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) RR(rr int64) *RRSshfpQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) TTL(ttl int64) *RRSshfpQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+func (b *RRSshfpQueryBuilder) Build() RRSshfpQuery {
+	return b.query
+}
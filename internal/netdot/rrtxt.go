@@ -0,0 +1,153 @@
+package netdot
+
+import (
+	"strconv"
+	"strings"
+)
+
+// longest character-string a TXT record can hold (RFC 1035, section 3.3)
+const txtStringMaxLength = 255
+
+type RRTxtQuery struct {
+	RR             *int64  `url:"rr"`
+	TTL            *int64  `url:"ttl"`
+	TxtData        *string `url:"txtdata"`
+	SkipDeletingRR *int64  `url:"skip_deleting_rr,omitempty"`
+}
+
+func (q RRTxtQuery) Builder() RRTxtQueryBuilder {
+	return RRTxtQueryBuilder{query: q}
+}
+
+type RRTxtQueryBuilder struct {
+	query RRTxtQuery
+}
+
+func NewRRTxtQueryBuilder() *RRTxtQueryBuilder {
+	return &RRTxtQueryBuilder{
+		query: RRTxtQuery{},
+	}
+}
+
+func (b *RRTxtQueryBuilder) SkipDeletingRR(skip bool) *RRTxtQueryBuilder {
+	boolInt := boolToInt(skip)
+	b.query.SkipDeletingRR = &boolInt
+	return b
+}
+
+func (b *RRTxtQueryBuilder) RR(rr int64) *RRTxtQueryBuilder {
+	b.query.RR = &rr
+	return b
+}
+
+func (b *RRTxtQueryBuilder) TTL(ttl int64) *RRTxtQueryBuilder {
+	b.query.TTL = &ttl
+	return b
+}
+
+// TxtData sets the raw txtdata as stored by netdot, use Value for an unsplit value
+func (b *RRTxtQueryBuilder) TxtData(txtData string) *RRTxtQueryBuilder {
+	b.query.TxtData = &txtData
+	return b
+}
+
+// Value sets txtdata to value split into quoted character-strings
+func (b *RRTxtQueryBuilder) Value(value string) *RRTxtQueryBuilder {
+	return b.TxtData(SplitTXT(value))
+}
+
+func (b *RRTxtQueryBuilder) Build() RRTxtQuery {
+	return b.query
+}
+
+// SplitTXT splits value into quoted character-strings of at most 255 bytes each,
+// e.g. a 300 byte DKIM key becomes "<first 255 bytes>" "<last 45 bytes>"
+func SplitTXT(value string) string {
+	if value == "" {
+		return `""`
+	}
+
+	chunks := []string{}
+	for len(value) > 0 {
+		n := min(len(value), txtStringMaxLength)
+		chunks = append(chunks, quoteTXTString(value[:n]))
+		value = value[n:]
+	}
+
+	return strings.Join(chunks, " ")
+}
+
+func quoteTXTString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// JoinTXT is the inverse of SplitTXT, the character-strings of txtData are concatenated.
+// txtData that is not made of quoted strings (e.g. entered through the netdot UI) is returned as is.
+func JoinTXT(txtData string) string {
+	rest := strings.TrimSpace(txtData)
+	if !strings.HasPrefix(rest, `"`) {
+		return txtData
+	}
+
+	var b strings.Builder
+	for rest != "" {
+		if rest[0] != '"' {
+			return txtData
+		}
+
+		i := 1
+		closed := false
+		for i < len(rest) {
+			switch rest[i] {
+			case '"':
+				closed = true
+			case '\\':
+				// \DDD is a decimal byte, any other escaped byte stands for itself
+				if i+3 < len(rest) && isDigits(rest[i+1:i+4]) {
+					if code, _ := strconv.Atoi(rest[i+1 : i+4]); code <= 255 {
+						b.WriteByte(byte(code))
+						i += 4
+						continue
+					}
+				}
+				if i+1 < len(rest) {
+					b.WriteByte(rest[i+1])
+					i += 2
+					continue
+				}
+				return txtData
+			default:
+				b.WriteByte(rest[i])
+			}
+			i++
+			if closed {
+				break
+			}
+		}
+
+		if !closed {
+			return txtData
+		}
+		rest = strings.TrimSpace(rest[i:])
+	}
+
+	return b.String()
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package netdot
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+type SubnetZoneQuery struct {
+	Subnet *int64 `url:"subnet"`
+	Zone   *int64 `url:"zone"`
+}
+
+type SubnetZoneQueryBuilder struct {
+	query SubnetZoneQuery
+}
+
+func NewSubnetZoneQueryBuilder() *SubnetZoneQueryBuilder {
+	return &SubnetZoneQueryBuilder{
+		query: SubnetZoneQuery{},
+	}
+}
+
+func (b *SubnetZoneQueryBuilder) Subnet(subnet int64) *SubnetZoneQueryBuilder {
+	b.query.Subnet = &subnet
+	return b
+}
+
+func (b *SubnetZoneQueryBuilder) Zone(zone int64) *SubnetZoneQueryBuilder {
+	b.query.Zone = &zone
+	return b
+}
+
+func (b *SubnetZoneQueryBuilder) Build() SubnetZoneQuery {
+	return b.query
+}
+
+type subnetZoneSearchResults struct {
+	SubnetZones []models.SubnetZone `xml:"SubnetZone"`
+}
+
+// list every subnet to zone link matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListSubnetZones(q SubnetZoneQuery) ([]models.SubnetZone, error) {
+	var results subnetZoneSearchResults
+	statusCode, err := c.Search("subnetzone", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.SubnetZone{}, nil
+		}
+		return nil, err
+	}
+
+	return results.SubnetZones, nil
+}
+
+// link a subnet to a zone unless the link already exists
+func (c *Client) EnsureSubnetZone(subnetID int64, zoneID int64) (models.SubnetZone, error) {
+	queryBuilder := NewSubnetZoneQueryBuilder()
+	queryBuilder.Subnet(subnetID)
+	queryBuilder.Zone(zoneID)
+	query := queryBuilder.Build()
+
+	existing, err := c.ListSubnetZones(query)
+	if err != nil {
+		return models.SubnetZone{}, err
+	}
+
+	if len(existing) > 0 {
+		return existing[0], nil
+	}
+
+	var subnetZone models.SubnetZone
+	err = c.CreateResource("subnetzone", query, &subnetZone)
+	if err != nil {
+		return models.SubnetZone{}, err
+	}
+
+	return subnetZone, nil
+}
+
+// remove the link between a subnet and a zone, a missing link is not an error
+func (c *Client) RemoveSubnetZone(subnetID int64, zoneID int64) error {
+	queryBuilder := NewSubnetZoneQueryBuilder()
+	queryBuilder.Subnet(subnetID)
+	queryBuilder.Zone(zoneID)
+
+	existing, err := c.ListSubnetZones(queryBuilder.Build())
+	if err != nil {
+		return err
+	}
+
+	for _, subnetZone := range existing {
+		err = c.DeleteResourceByID("subnetzone", subnetZone.ID, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete a zone unless a subnet other than subnetID is still linked to it, a missing zone is not an error.
+// The returned bool reports whether the zone is gone.
+func (c *Client) DeleteZoneUnlessLinked(zoneID int64, subnetID int64) (bool, error) {
+	queryBuilder := NewSubnetZoneQueryBuilder()
+	queryBuilder.Zone(zoneID)
+	subnetZones, err := c.ListSubnetZones(queryBuilder.Build())
+	if err != nil {
+		return false, err
+	}
+
+	for _, subnetZone := range subnetZones {
+		if subnetZone.SubnetXlink.ID != subnetID {
+			return false, nil
+		}
+	}
+
+	var zone models.Zone
+	statusCode, err := c.GetResourceByID("zone", zoneID, &zone)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return true, nil
+		}
+		return false, err
+	}
+
+	err = c.DeleteResourceByID("zone", zoneID, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// whether the reverse zone of prefix holds exactly its addresses, rather than rounding out to the
+// enclosing octet (IPv4) or nibble (IPv6) boundary
+func ReverseZoneMatchesPrefix(prefix netip.Prefix) bool {
+	if prefix.Addr().Is4() {
+		return prefix.Bits()%8 == 0
+	}
+	return prefix.Bits()%4 == 0
+}
+
+// ReverseZoneName returns the smallest in-addr.arpa (octet boundary) or ip6.arpa (nibble boundary)
+// zone that holds the PTR records of every address in prefix
+func ReverseZoneName(prefix netip.Prefix) string {
+	prefix = prefix.Masked()
+	addr := prefix.Addr()
+
+	if addr.Is4() {
+		octets := addr.As4()
+		labels := []string{}
+		for i := 0; i < prefix.Bits()/8; i++ {
+			labels = append([]string{fmt.Sprint(octets[i])}, labels...)
+		}
+		return strings.Join(append(labels, "in-addr", "arpa"), ".")
+	}
+
+	bytes := addr.As16()
+	labels := []string{}
+	for i := 0; i < prefix.Bits()/4; i++ {
+		nibble := bytes[i/2] >> 4
+		if i%2 == 1 {
+			nibble = bytes[i/2] & 0x0f
+		}
+		labels = append([]string{fmt.Sprintf("%x", nibble)}, labels...)
+	}
+	return strings.Join(append(labels, "ip6", "arpa"), ".")
+}
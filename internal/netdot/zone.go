@@ -0,0 +1,188 @@
+package netdot
+
+import (
+	"fmt"
+	"net/http"
+	"terraform-provider-netdot/internal/netdot/models"
+)
+
+// SOA fields are left out when unset so netdot fills in its configured defaults
+type ZoneQuery struct {
+	Name       *string `url:"name"`
+	Active     *int64  `url:"active,omitempty"`
+	DefaultTTL *int64  `url:"default_ttl,omitempty"`
+	Expire     *int64  `url:"expire,omitempty"`
+	ExportFile *string `url:"export_file,omitempty"`
+	Info       *string `url:"info"`
+	Minimum    *int64  `url:"minimum,omitempty"`
+	MName      *string `url:"mname,omitempty"`
+	Refresh    *int64  `url:"refresh,omitempty"`
+	Retry      *int64  `url:"retry,omitempty"`
+	RName      *string `url:"rname,omitempty"`
+	Serial     *int64  `url:"serial,omitempty"`
+}
+
+func (q ZoneQuery) Builder() ZoneQueryBuilder {
+	return ZoneQueryBuilder{query: q}
+}
+
+type ZoneQueryBuilder struct {
+	query ZoneQuery
+}
+
+func NewZoneQueryBuilder() *ZoneQueryBuilder {
+	return &ZoneQueryBuilder{
+		query: ZoneQuery{},
+	}
+}
+
+func (b *ZoneQueryBuilder) Name(name string) *ZoneQueryBuilder {
+	b.query.Name = &name
+	return b
+}
+
+func (b *ZoneQueryBuilder) Active(active bool) *ZoneQueryBuilder {
+	intBool := boolToInt(active)
+	b.query.Active = &intBool
+	return b
+}
+
+func (b *ZoneQueryBuilder) DefaultTTL(defaultTTL int64) *ZoneQueryBuilder {
+	b.query.DefaultTTL = &defaultTTL
+	return b
+}
+
+func (b *ZoneQueryBuilder) Expire(expire int64) *ZoneQueryBuilder {
+	b.query.Expire = &expire
+	return b
+}
+
+func (b *ZoneQueryBuilder) ExportFile(exportFile string) *ZoneQueryBuilder {
+	b.query.ExportFile = &exportFile
+	return b
+}
+
+func (b *ZoneQueryBuilder) Info(info string) *ZoneQueryBuilder {
+	b.query.Info = &info
+	return b
+}
+
+func (b *ZoneQueryBuilder) Minimum(minimum int64) *ZoneQueryBuilder {
+	b.query.Minimum = &minimum
+	return b
+}
+
+func (b *ZoneQueryBuilder) MName(mname string) *ZoneQueryBuilder {
+	b.query.MName = &mname
+	return b
+}
+
+func (b *ZoneQueryBuilder) Refresh(refresh int64) *ZoneQueryBuilder {
+	b.query.Refresh = &refresh
+	return b
+}
+
+func (b *ZoneQueryBuilder) Retry(retry int64) *ZoneQueryBuilder {
+	b.query.Retry = &retry
+	return b
+}
+
+func (b *ZoneQueryBuilder) RName(rname string) *ZoneQueryBuilder {
+	b.query.RName = &rname
+	return b
+}
+
+func (b *ZoneQueryBuilder) Serial(serial int64) *ZoneQueryBuilder {
+	b.query.Serial = &serial
+	return b
+}
+
+func (b *ZoneQueryBuilder) Build() ZoneQuery {
+	return b.query
+}
+
+type zoneSearchResults struct {
+	Zones []models.Zone `xml:"Zone"`
+}
+
+// list every zone matching the query, an empty slice is returned when nothing matches
+func (c *Client) ListZones(q ZoneQuery) ([]models.Zone, error) {
+	var results zoneSearchResults
+	statusCode, err := c.Search("zone", q, &results)
+	if err != nil {
+		if statusCode != nil && *statusCode == http.StatusNotFound {
+			return []models.Zone{}, nil
+		}
+		return nil, err
+	}
+
+	return results.Zones, nil
+}
+
+// the zones whose normalized name is name
+func (c *Client) listZonesNamed(name string) ([]models.Zone, error) {
+	queryBuilder := NewZoneQueryBuilder()
+	queryBuilder.Name(name)
+	zones, err := c.ListZones(queryBuilder.Build())
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []models.Zone{}
+	for _, zone := range zones {
+		if NormalizeFQDN(zone.Name) == name {
+			matches = append(matches, zone)
+		}
+	}
+
+	return matches, nil
+}
+
+// get the single zone with exactly the given name
+func (c *Client) GetZoneByName(name string) (models.Zone, error) {
+	name = NormalizeFQDN(name)
+
+	matches, err := c.listZonesNamed(name)
+	if err != nil {
+		return models.Zone{}, err
+	}
+
+	if len(matches) == 0 {
+		return models.Zone{}, fmt.Errorf("no zone found named %s", name)
+	}
+
+	if len(matches) > 1 {
+		return models.Zone{}, fmt.Errorf("%d zones found named %s", len(matches), name)
+	}
+
+	return matches[0], nil
+}
+
+// get the zone with the given name, creating it with netdot's default SOA settings when it does not exist.
+// The returned bool reports whether the zone was created.
+func (c *Client) EnsureZone(name string) (models.Zone, bool, error) {
+	name = NormalizeFQDN(name)
+
+	queryBuilder := NewZoneQueryBuilder()
+	queryBuilder.Name(name)
+	query := queryBuilder.Build()
+
+	zones, err := c.ListZones(query)
+	if err != nil {
+		return models.Zone{}, false, err
+	}
+
+	for _, zone := range zones {
+		if NormalizeFQDN(zone.Name) == name {
+			return zone, false, nil
+		}
+	}
+
+	var zone models.Zone
+	err = c.CreateResource("zone", query, &zone)
+	if err != nil {
+		return models.Zone{}, false, err
+	}
+
+	return zone, true, nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type availableAddressesModel struct {
+	SubnetID     types.Int64    `tfsdk:"subnet_id"`
+	Subnet       types.String   `tfsdk:"subnet"`
+	AddressCount types.Int64    `tfsdk:"address_count"`
+	Strategy     types.String   `tfsdk:"strategy"`
+	Addresses    []types.String `tfsdk:"addresses"`
+	FreeCount    types.Int64    `tfsdk:"free_count"`
+}
+
+var availableAddressesDataSourceSchema = datasourceSchema.Schema{
+	Description: "Preview the addresses that would be allocated from a subnet, without reserving them.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"subnet_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the subnet IP block to search.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"subnet": datasourceSchema.StringAttribute{
+			Description: "CIDR of the subnet IP block to search.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"address_count": datasourceSchema.Int64Attribute{
+			Description: "Number of addresses to return. Defaults to 1.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"strategy": datasourceSchema.StringAttribute{
+			Description: "Allocation strategy, first_free | last_free. Defaults to first_free.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"addresses": datasourceSchema.ListAttribute{
+			Description: "Free addresses in the order they would be allocated. May hold fewer than address_count entries when the subnet is nearly full.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"free_count": datasourceSchema.Int64Attribute{
+			Description: "Total number of free addresses in the subnet, capped at the largest 64 bit integer for large IPv6 subnets.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &availableAddressesDataSource{}
+)
+
+func NewAvailableAddressesDataSource() datasource.DataSource {
+	return &availableAddressesDataSource{}
+}
+
+type availableAddressesDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *availableAddressesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *availableAddressesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_addresses"
+}
+
+func (d *availableAddressesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = availableAddressesDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *availableAddressesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state availableAddressesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SubnetID.IsNull() && state.Subnet.IsNull() {
+		resp.Diagnostics.AddError("Subnet ID or Subnet is required", "Subnet ID or Subnet must be provided")
+		return
+	}
+
+	if !state.SubnetID.IsNull() && !state.Subnet.IsNull() {
+		resp.Diagnostics.AddError("Subnet ID and Subnet are mutually exclusive", "Only one of Subnet ID or Subnet can be provided")
+		return
+	}
+
+	if state.AddressCount.IsNull() {
+		state.AddressCount = types.Int64Value(1)
+	}
+
+	if state.AddressCount.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("address_count"), "Invalid address count", "address_count must not be negative")
+		return
+	}
+
+	if state.Strategy.IsNull() {
+		state.Strategy = types.StringValue("first_free")
+	}
+
+	strategy, err := netdot.ParseIPAllocationStrategy(state.Strategy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("strategy"), "Invalid strategy", err.Error())
+		return
+	}
+
+	var subnet models.IpBlock
+
+	if !state.SubnetID.IsNull() {
+		_, err := d.client.GetResourceByID("ipblock", state.SubnetID.ValueInt64(), &subnet)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subnet", err.Error())
+			return
+		}
+	} else {
+		prefix, err := netip.ParsePrefix(state.Subnet.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet"), "Invalid CIDR", err.Error())
+			return
+		}
+
+		subnet, err = d.client.GetIpBlockByPrefix(prefix.Masked())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subnet", err.Error())
+			return
+		}
+	}
+
+	state.SubnetID = types.Int64Value(subnet.ID)
+	if state.Subnet.IsNull() {
+		state.Subnet = types.StringValue(fmt.Sprintf("%s/%d", subnet.Address, subnet.Prefix))
+	}
+
+	availableIPs, freeCount, err := d.client.GetAvailableIPs(subnet.ID, strategy, int(state.AddressCount.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading available addresses", err.Error())
+		return
+	}
+
+	state.Addresses = make([]types.String, 0, len(availableIPs))
+	for _, availableIP := range availableIPs {
+		state.Addresses = append(state.Addresses, types.StringValue(availableIP.Address))
+	}
+	state.FreeCount = types.Int64Value(freeCount)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type containingIpblockModel struct {
+	Address    types.String   `tfsdk:"address"`
+	WalkToRoot types.Bool     `tfsdk:"walk_to_root"`
+	IpBlock    *ipblockModel  `tfsdk:"ipblock"`
+	Ancestors  []ipblockModel `tfsdk:"ancestors"`
+}
+
+var containingIpblockDataSourceSchema = datasourceSchema.Schema{
+	Description: "Finds the most specific Subnet or Container in Netdot that holds an address, e.g. to learn the VLAN, owner and gateway of an IP handed out by a cloud or hypervisor. netdot_ipblock can do the same lookup through its containing attribute; this data source also returns every block above the match.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"address": datasourceSchema.StringAttribute{
+			Description: "IP address, or CIDR, to look up.",
+			Required:    true,
+		},
+		"walk_to_root": datasourceSchema.BoolAttribute{
+			Description: "Also return every block above the match, up to the root of the tree.",
+			Optional:    true,
+		},
+		"ipblock": datasourceSchema.SingleNestedAttribute{
+			Description: "The most specific Subnet or Container holding the address.",
+			Computed:    true,
+			Attributes:  ipblockComputedDataSourceAttributes(),
+		},
+		"ancestors": datasourceSchema.ListNestedAttribute{
+			Description: "Blocks above the match, nearest parent first. Empty unless walk_to_root is set.",
+			Computed:    true,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: ipblockComputedDataSourceAttributes(),
+			},
+		},
+	},
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &containingIpblockDataSource{}
+)
+
+func NewContainingIpblockDataSource() datasource.DataSource {
+	return &containingIpblockDataSource{}
+}
+
+type containingIpblockDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *containingIpblockDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *containingIpblockDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_containing_ipblock"
+}
+
+func (d *containingIpblockDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = containingIpblockDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *containingIpblockDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state containingIpblockModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, _, err := netdot.ParseAddressOrPrefix(state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("address"), "Invalid address", fmt.Sprintf("address must be an IP address or CIDR: %s", err))
+		return
+	}
+
+	containing, err := d.client.GetContainingIpBlock(prefix)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding containing IP block", err.Error())
+		return
+	}
+
+	ipblock := IPBlockToIpblockModel(containing)
+	state.IpBlock = &ipblock
+	state.Ancestors = []ipblockModel{}
+
+	if state.WalkToRoot.ValueBool() {
+		ancestors, err := d.client.GetIpBlockAncestors(containing)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading IP block ancestors", err.Error())
+			return
+		}
+
+		for _, ancestor := range ancestors {
+			state.Ancestors = append(state.Ancestors, IPBlockToIpblockModel(ancestor))
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &dsDigestFunction{}
+)
+
+func NewDSDigestFunction() function.Function {
+	return &dsDigestFunction{}
+}
+
+type dsDigestFunction struct{}
+
+func (f *dsDigestFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ds_digest"
+}
+
+func (f *dsDigestFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Digest of the DS record pointing at a DNSKEY.",
+		Description: "Computes the digest a DS record in the parent zone needs for a DNSKEY of a signed child zone, the same value dnssec-dsfromkey prints, in uppercase hex.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "owner",
+				Description: "Name of the zone the DNSKEY belongs to, e.g. sub.uoregon.edu.",
+			},
+			function.Int64Parameter{
+				Name:        "flags",
+				Description: "Flags of the DNSKEY, usually 257.",
+			},
+			function.Int64Parameter{
+				Name:        "protocol",
+				Description: "Protocol of the DNSKEY, always 3.",
+			},
+			function.Int64Parameter{
+				Name:        "algorithm",
+				Description: "Algorithm of the DNSKEY, e.g. 13.",
+			},
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "Public key of the DNSKEY in base64.",
+			},
+			function.Int64Parameter{
+				Name:        "digest_type",
+				Description: "Hash to use: 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *dsDigestFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var owner, publicKey string
+	var flags, protocol, algorithm, digestType int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &owner, &flags, &protocol, &algorithm, &publicKey, &digestType))
+	if resp.Error != nil {
+		return
+	}
+
+	digest, err := netdot.DSDigest(owner, flags, protocol, algorithm, publicKey, digestType)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, digest))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &eui64AddressFunction{}
+)
+
+func NewEUI64AddressFunction() function.Function {
+	return &eui64AddressFunction{}
+}
+
+type eui64AddressFunction struct{}
+
+func (f *eui64AddressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "eui64_address"
+}
+
+func (f *eui64AddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Modified EUI-64 address of a MAC address inside an IPv6 /64.",
+		Description: "Builds the SLAAC style address a host with the given 48-bit MAC address takes in an IPv6 /64. The address is not checked against netdot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "IPv6 /64 in CIDR notation, e.g. 2001:db8:1:2::/64.",
+			},
+			function.StringParameter{
+				Name:        "mac",
+				Description: "48-bit MAC address, e.g. 00:11:22:33:44:55.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *eui64AddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefixString, mac string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefixString, &mac))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, isCIDR, err := netdot.ParseAddressOrPrefix(prefixString)
+	if err != nil || !isCIDR {
+		resp.Error = function.NewArgumentFuncError(0, "prefix must be an IPv6 /64 in CIDR notation")
+		return
+	}
+
+	addr, err := netdot.EUI64Address(prefix, mac)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, addr.String()))
+}
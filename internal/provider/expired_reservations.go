@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type expiredReservationsModel struct {
+	ParentID     types.Int64               `tfsdk:"parent_id"`
+	At           types.String              `tfsdk:"at"`
+	Reservations []expiredReservationModel `tfsdk:"reservations"`
+}
+
+type expiredReservationModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Address       types.String `tfsdk:"address"`
+	Prefix        types.Int64  `tfsdk:"prefix"`
+	Status        types.String `tfsdk:"status"`
+	Description   types.String `tfsdk:"description"`
+	ParentID      types.Int64  `tfsdk:"parent_id"`
+	ReservedUntil types.String `tfsdk:"reserved_until"`
+}
+
+var expiredReservationAttrTypes = map[string]attr.Type{
+	"id":             types.Int64Type,
+	"address":        types.StringType,
+	"prefix":         types.Int64Type,
+	"status":         types.StringType,
+	"description":    types.StringType,
+	"parent_id":      types.Int64Type,
+	"reserved_until": types.StringType,
+}
+
+func IpBlockReservationToExpiredReservationModel(reservation netdot.IpBlockReservation) expiredReservationModel {
+	var finalModel expiredReservationModel
+
+	finalModel.ID = types.Int64Value(reservation.IpBlock.ID)
+	finalModel.Address = types.StringValue(reservation.IpBlock.Address)
+	finalModel.Prefix = types.Int64Value(reservation.IpBlock.Prefix)
+	finalModel.Status = types.StringValue(reservation.IpBlock.Status)
+	finalModel.Description = autoNullString(reservation.IpBlock.Description)
+	finalModel.ParentID = autoNullInt64(reservation.IpBlock.ParentXlink.ID)
+	finalModel.ReservedUntil = types.StringValue(reservation.ReservedUntil.Format(time.RFC3339))
+
+	return finalModel
+}
+
+var expiredReservationsDataSourceSchema = datasourceSchema.Schema{
+	Description: "IP blocks whose reserved_until (set through netdot_ipblock) has passed. Use netdot_reservation_reaper to release them.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"parent_id": datasourceSchema.Int64Attribute{
+			Description: "Only look at direct children of this ipblock. Every ipblock on the server is searched when not set, which can be slow.",
+			Optional:    true,
+		},
+		"at": datasourceSchema.StringAttribute{
+			Description: "RFC 3339 timestamp to compare reservations against. Defaults to the current time.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"reservations": datasourceSchema.ListNestedAttribute{
+			Description: "The expired reservations, ordered by reserved_until.",
+			Computed:    true,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: map[string]datasourceSchema.Attribute{
+					"id": datasourceSchema.Int64Attribute{
+						Description: "ID of the IP block.",
+						Computed:    true,
+					},
+					"address": datasourceSchema.StringAttribute{
+						Description: "Address of the IP block.",
+						Computed:    true,
+					},
+					"prefix": datasourceSchema.Int64Attribute{
+						Description: "The prefix length of the IP block.",
+						Computed:    true,
+					},
+					"status": datasourceSchema.StringAttribute{
+						Description: "Status of the IP block when it was found.",
+						Computed:    true,
+					},
+					"description": datasourceSchema.StringAttribute{
+						Description: "Description of the IP block when it was found.",
+						Computed:    true,
+					},
+					"parent_id": datasourceSchema.Int64Attribute{
+						Description: "ID of the parent IP block.",
+						Computed:    true,
+					},
+					"reserved_until": datasourceSchema.StringAttribute{
+						Description: "When the reservation ran out.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
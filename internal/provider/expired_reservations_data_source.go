@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-netdot/internal/netdot"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &expiredReservationsDataSource{}
+)
+
+func NewExpiredReservationsDataSource() datasource.DataSource {
+	return &expiredReservationsDataSource{}
+}
+
+type expiredReservationsDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *expiredReservationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *expiredReservationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expired_reservations"
+}
+
+func (d *expiredReservationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = expiredReservationsDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *expiredReservationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state expiredReservationsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	at := time.Now().UTC()
+	if isPopulated(state.At) {
+		parsed, err := time.Parse(time.RFC3339, state.At.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("at"), "Invalid at", fmt.Sprintf("at must be an RFC 3339 timestamp such as 2026-11-01T00:00:00Z: %s", err))
+			return
+		}
+		at = parsed
+	} else {
+		state.At = types.StringValue(at.Format(time.RFC3339))
+	}
+
+	var parentID *int64
+	if isPopulated(state.ParentID) {
+		id := state.ParentID.ValueInt64()
+		parentID = &id
+	}
+
+	expired, err := d.client.ListExpiredReservations(parentID, at)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading expired reservations", err.Error())
+		return
+	}
+
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].ReservedUntil.Before(expired[j].ReservedUntil)
+	})
+
+	state.Reservations = make([]expiredReservationModel, 0, len(expired))
+	for _, reservation := range expired {
+		state.Reservations = append(state.Reservations, IpBlockReservationToExpiredReservationModel(reservation))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
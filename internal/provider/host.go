@@ -1,84 +1,292 @@
 package provider
 
-// import (
-// 	"terraform-provider-netdot/internal/netdot"
-// 	"terraform-provider-netdot/internal/netdot/models"
-
-// 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
-// 	"github.com/hashicorp/terraform-plugin-framework/types"
-// )
-
-// type hostModel struct {
-// 	ID         types.Int64  `tfsdk:"id"`
-// 	Active     types.Bool   `tfsdk:"active"`
-// 	AutoUpdate types.Bool   `tfsdk:"auto_update"`
-// 	Expiration types.String `tfsdk:"expiration"`
-// 	Info       types.String `tfsdk:"info"`
-// 	Name       types.String `tfsdk:"name"`
-// 	Zone       types.String `tfsdk:"zone"`
-// 	ZoneID     types.Int64  `tfsdk:"zone_id"`
-// 	FQDN       types.String `tfsdk:"fqdn"`
-// 	// nested objects
-// 	IpBlocks ipblockModel `tfsdk:"ip_addresses"`
-// }
-
-// func RRToHostModel(rr models.RR) hostModel {
-// 	var finalModel hostModel
-
-// 	finalModel.ID = types.Int64Value(rr.ID)
-// 	finalModel.Active = types.BoolValue(rr.Active)
-// 	finalModel.AutoUpdate = types.BoolValue(rr.AutoUpdate)
-// 	finalModel.Expiration = autoNullString(rr.Expiration)
-// 	finalModel.Info = autoNullString(rr.Info)
-// 	finalModel.Name = types.StringValue(rr.Name)
-// 	finalModel.Zone = types.StringValue(rr.Zone)
-// 	finalModel.ZoneID = types.Int64Value(rr.ZoneXlink.ID)
-// 	finalModel.FQDN = types.StringValue(rr.Name + "." + rr.Zone)
-
-// 	return finalModel
-// }
-
-// func HostModelToRRQuery(model hostModel) netdot.RRQuery {
-// 	rrQuery := netdot.NewRRQueryBuilder()
-
-// 	if isPopulated(model.Active) {
-// 		rrQuery.Active(model.Active.ValueBool())
-// 	}
-
-// 	if isPopulated(model.AutoUpdate) {
-// 		rrQuery.AutoUpdate(model.AutoUpdate.ValueBool())
-// 	}
-
-// 	if isPopulated(model.Expiration) {
-// 		rrQuery.Expiration(model.Expiration.ValueString())
-// 	}
-
-// 	if isPopulated(model.Info) {
-// 		rrQuery.Info(model.Info.ValueString())
-// 	}
-
-// 	if isPopulated(model.Name) {
-// 		rrQuery.Name(model.Name.ValueString())
-// 	}
-
-// 	if isPopulated(model.ZoneID) {
-// 		rrQuery.ZoneID(model.ZoneID.ValueInt64())
-// 	}
-
-// 	return rrQuery.Build()
-// }
-
-// var hostResourceSchema = rrResourceSchema
-
-// func appendSchemaAttributesAsSetNetstedAttribute(schema *resourceSchema.Schema, attributeName string, attributes map[string]resourceSchema.Attribute) {
-// 	schema.Attributes[attributeName] = resourceSchema.SetNestedAttribute{
-// 		NestedObject: resourceSchema.NestedAttributeObject{
-// 			Attributes: attributes,
-// 		},
-// 		Optional: true,
-// 	}
-// }
-
-// func init() {
-// 	appendSchemaAttributesAsSetNetstedAttribute(&hostResourceSchema, "ip_addresses", ipblockResourceSchema.Attributes)
-// }
+import (
+	"context"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type hostModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Address   types.String `tfsdk:"address"`
+	Addresses types.List   `tfsdk:"addresses"`
+	ARecordID types.Int64  `tfsdk:"arecord_id"`
+	FQDN      types.String `tfsdk:"fqdn"`
+	IpBlockID types.Int64  `tfsdk:"ipblock_id"`
+	Name      types.String `tfsdk:"name"`
+	PTRID     types.Int64  `tfsdk:"ptr_id"`
+	Subnet    types.String `tfsdk:"subnet"`
+	Subnets   types.List   `tfsdk:"subnets"`
+	Zone      types.String `tfsdk:"zone"`
+}
+
+// hostAddressModel is one element of addresses
+type hostAddressModel struct {
+	Address    types.String `tfsdk:"address"`
+	IpBlockID  types.Int64  `tfsdk:"ipblock_id"`
+	PTRID      types.Int64  `tfsdk:"ptr_id"`
+	RecordID   types.Int64  `tfsdk:"record_id"`
+	RecordType types.String `tfsdk:"record_type"`
+	Reused     types.Bool   `tfsdk:"reused"`
+}
+
+var hostAddressAttrTypes = map[string]attr.Type{
+	"address":     types.StringType,
+	"ipblock_id":  types.Int64Type,
+	"ptr_id":      types.Int64Type,
+	"record_id":   types.Int64Type,
+	"record_type": types.StringType,
+	"reused":      types.BoolType,
+}
+
+// HostRecordsToHostModel fills in everything but subnet and subnets, which netdot does not keep.
+// The single address attributes describe the first address.
+func HostRecordsToHostModel(records netdot.HostRecords) hostModel {
+	var finalModel hostModel
+
+	finalModel.ID = types.Int64Value(records.RR.ID)
+	finalModel.FQDN = types.StringValue(netdot.HostFQDN(records.RR.Name, records.RR.Zone))
+	finalModel.Name = types.StringValue(records.RR.Name)
+	finalModel.Subnet = types.StringNull()
+	finalModel.Subnets = types.ListNull(types.StringType)
+	finalModel.Zone = types.StringValue(records.RR.Zone)
+
+	finalModel.Address = types.StringNull()
+	finalModel.ARecordID = types.Int64Null()
+	finalModel.IpBlockID = types.Int64Null()
+	finalModel.PTRID = types.Int64Null()
+
+	addresses := make([]attr.Value, 0, len(records.Addresses))
+	for i, address := range records.Addresses {
+		ptrID := types.Int64Null()
+		if address.RRPtr != nil {
+			ptrID = types.Int64Value(address.RRPtr.ID)
+		}
+
+		recordType := types.StringNull()
+		addr, err := netip.ParseAddr(address.IpBlock.Address)
+		if err == nil {
+			recordType = types.StringValue(netdot.AddressRecordType(addr))
+		}
+
+		addresses = append(addresses, types.ObjectValueMust(hostAddressAttrTypes, map[string]attr.Value{
+			"address":     types.StringValue(address.IpBlock.Address),
+			"ipblock_id":  types.Int64Value(address.IpBlock.ID),
+			"ptr_id":      ptrID,
+			"record_id":   types.Int64Value(address.RRAddr.ID),
+			"record_type": recordType,
+			"reused":      types.BoolValue(address.Reused),
+		}))
+
+		if i == 0 {
+			finalModel.Address = types.StringValue(address.IpBlock.Address)
+			finalModel.ARecordID = types.Int64Value(address.RRAddr.ID)
+			finalModel.IpBlockID = types.Int64Value(address.IpBlock.ID)
+			finalModel.PTRID = ptrID
+		}
+	}
+	finalModel.Addresses = types.ListValueMust(types.ObjectType{AttrTypes: hostAddressAttrTypes}, addresses)
+
+	return finalModel
+}
+
+// ids of the ipblocks in the model's addresses that were Available before the host took them
+func hostReusedIpBlockIDs(ctx context.Context, model hostModel) ([]int64, diag.Diagnostics) {
+	var addresses []hostAddressModel
+	diags := model.Addresses.ElementsAs(ctx, &addresses, false)
+
+	ids := []int64{}
+	for _, address := range addresses {
+		if address.Reused.ValueBool() {
+			ids = append(ids, address.IpBlockID.ValueInt64())
+		}
+	}
+
+	return ids, diags
+}
+
+// subnets only matter when the addresses are allocated, so a host imported or created with a fixed address
+// is only kept when subnets are added to its configuration that describe the addresses it already has.
+// Anything else would need new addresses, which replacing the host allocates.
+func requiresReplaceIfSubnetWasSet(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if !req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = !hostAddressesMatchSubnets(ctx, req.State, []types.String{req.PlanValue})
+}
+
+func requiresReplaceIfSubnetsWereSet(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if !req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var subnets []types.String
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &subnets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = !hostAddressesMatchSubnets(ctx, req.State, subnets)
+}
+
+// hostAddressesMatchSubnets reports whether the host in state has exactly one address per subnet, each inside its subnet
+func hostAddressesMatchSubnets(ctx context.Context, state tfsdk.State, subnets []types.String) bool {
+	var addresses []hostAddressModel
+	diags := state.GetAttribute(ctx, path.Root("addresses"), &addresses)
+	if diags.HasError() || len(addresses) != len(subnets) {
+		return false
+	}
+
+	prefixes := map[string]netip.Prefix{}
+	for _, subnet := range subnets {
+		if !isPopulated(subnet) {
+			return false
+		}
+		prefix, err := netip.ParsePrefix(subnet.ValueString())
+		if err != nil {
+			return false
+		}
+		prefixes[netdot.AddressRecordType(prefix.Addr())] = prefix
+	}
+
+	for _, address := range addresses {
+		addr, err := netip.ParseAddr(address.Address.ValueString())
+		if err != nil {
+			return false
+		}
+		prefix, ok := prefixes[netdot.AddressRecordType(addr)]
+		if !ok || !prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var hostResourceSchema = resourceSchema.Schema{
+	Description: "A host is a resource record with addresses allocated from one or more subnets, created together with its A or AAAA records and PTR records. If any of them cannot be created, the whole host is deleted again. Deleting the host deletes all of them and frees the addresses, an address that was an Available IP block before is returned to Available rather than deleted.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the host's resource record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"address": resourceSchema.StringAttribute{
+			Description: "Address of the host, the first one when subnets is set. Set it to use a fixed address instead of allocating one from subnet.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"addresses": resourceSchema.ListNestedAttribute{
+			Description: "Every address of the host, in the order of subnets.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: map[string]resourceSchema.Attribute{
+					"address": resourceSchema.StringAttribute{
+						Description: "The address.",
+						Computed:    true,
+					},
+					"ipblock_id": resourceSchema.Int64Attribute{
+						Description: "ID of the ipblock of the address.",
+						Computed:    true,
+					},
+					"ptr_id": resourceSchema.Int64Attribute{
+						Description: "ID of the PTR record pointing the address back at the host.",
+						Computed:    true,
+					},
+					"record_id": resourceSchema.Int64Attribute{
+						Description: "ID of the A or AAAA record pointing at the address.",
+						Computed:    true,
+					},
+					"record_type": resourceSchema.StringAttribute{
+						Description: "A for an IPv4 address, AAAA for an IPv6 address.",
+						Computed:    true,
+					},
+					"reused": resourceSchema.BoolAttribute{
+						Description: "Whether the address was an Available IP block before the host took it. Destroying the host returns such a block to Available instead of deleting it.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"arecord_id": resourceSchema.Int64Attribute{
+			Description: "ID of the A or AAAA record of the host's first address.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"fqdn": resourceSchema.StringAttribute{
+			Description: "Fully qualified domain name of the host.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipblock_id": resourceSchema.Int64Attribute{
+			Description: "ID of the ipblock of the host's first address.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": resourceSchema.StringAttribute{
+			Description: "Name of the host inside zone.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ptr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the PTR record pointing the first address back at the host.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"subnet": resourceSchema.StringAttribute{
+			Description: "CIDR of the subnet the address is allocated from, e.g. 184.171.0.0/24. Exactly one of subnet, subnets and address must be set.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(requiresReplaceIfSubnetWasSet, "Changing the subnet of an allocated address, or setting one that does not contain the current address, replaces the host.", "Changing the subnet of an allocated address, or setting one that does not contain the current address, replaces the host."),
+			},
+		},
+		"subnets": resourceSchema.ListAttribute{
+			Description: "CIDRs of the subnets to allocate one address each from, at most one per address family, e.g. [\"184.171.0.0/24\", \"2607:8400:2880:4::/64\"] for a dual-stack host.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplaceIf(requiresReplaceIfSubnetsWereSet, "Changing the subnets of allocated addresses, or setting subnets that do not match the current addresses, replaces the host.", "Changing the subnets of allocated addresses, or setting subnets that do not match the current addresses, replaces the host."),
+			},
+		},
+		"zone": resourceSchema.StringAttribute{
+			Description: "Zone of the host, e.g. uoregon.edu.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &hostResource{}
+	_ resource.ResourceWithImportState    = &hostResource{}
+	_ resource.ResourceWithValidateConfig = &hostResource{}
+)
+
+func NewHostResource() resource.Resource {
+	return &hostResource{}
+}
+
+type hostResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *hostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *hostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+func (d *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = hostResourceSchema
+}
+
+// ValidateConfig makes sure the addresses are either allocated from subnets or fixed, not both,
+// and that no address family is allocated from twice.
+func (d *hostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config hostModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Subnet.IsUnknown() || config.Subnets.IsUnknown() || config.Address.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, isNull := range []bool{config.Subnet.IsNull(), config.Subnets.IsNull(), config.Address.IsNull()} {
+		if !isNull {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError("Invalid host", "Exactly one of subnet, subnets and address must be set")
+		return
+	}
+
+	if isPopulated(config.Subnet) {
+		_, isCIDR, err := netdot.ParseAddressOrPrefix(config.Subnet.ValueString())
+		if err != nil || !isCIDR {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet"), "Invalid subnet", fmt.Sprintf("subnet must be in CIDR notation, got %q", config.Subnet.ValueString()))
+		}
+	}
+
+	if !config.Subnets.IsNull() {
+		var subnets []types.String
+		resp.Diagnostics.Append(config.Subnets.ElementsAs(ctx, &subnets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(subnets) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("subnets"), "Invalid subnets", "subnets must not be empty")
+			return
+		}
+
+		seenFamilies := map[string]string{}
+		for i, subnet := range subnets {
+			if subnet.IsUnknown() {
+				continue
+			}
+
+			prefix, isCIDR, err := netdot.ParseAddressOrPrefix(subnet.ValueString())
+			if err != nil || !isCIDR {
+				resp.Diagnostics.AddAttributeError(path.Root("subnets").AtListIndex(i), "Invalid subnet", fmt.Sprintf("subnet must be in CIDR notation, got %q", subnet.ValueString()))
+				continue
+			}
+
+			recordType := netdot.AddressRecordType(prefix.Addr())
+			if previous, ok := seenFamilies[recordType]; ok {
+				resp.Diagnostics.AddAttributeError(path.Root("subnets").AtListIndex(i), "Invalid subnets", fmt.Sprintf("%s and %s are of the same address family, a host gets at most one address per family", previous, subnet.ValueString()))
+				continue
+			}
+			seenFamilies[recordType] = subnet.ValueString()
+		}
+	}
+
+	if isPopulated(config.Address) {
+		_, err := netip.ParseAddr(config.Address.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("address"), "Invalid address", err.Error())
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	records, httpStatusCode, err := d.client.GetHostRecords(state.ID.ValueInt64())
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading host", err.Error())
+		return
+	}
+
+	// netdot does not remember which ipblocks were Available before, so that comes from the prior state
+	reusedIDs, diags := hostReusedIpBlockIDs(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range records.Addresses {
+		records.Addresses[i].Reused = slices.Contains(reusedIDs, records.Addresses[i].IpBlock.ID)
+	}
+
+	newState := HostRecordsToHostModel(records)
+	newState.Subnet = state.Subnet
+	newState.Subnets = state.Subnets
+
+	// Set state
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hostModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := netdot.HostFQDN(plan.Name.ValueString(), plan.Zone.ValueString())
+
+	var address string
+	if isPopulated(plan.Address) {
+		address = plan.Address.ValueString()
+	}
+
+	var subnets []netip.Prefix
+	if isPopulated(plan.Subnet) {
+		prefix, err := netip.ParsePrefix(plan.Subnet.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet"), "Invalid subnet", err.Error())
+			return
+		}
+		subnets = append(subnets, prefix)
+	}
+	if !plan.Subnets.IsNull() && !plan.Subnets.IsUnknown() {
+		var subnetStrings []string
+		resp.Diagnostics.Append(plan.Subnets.ElementsAs(ctx, &subnetStrings, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for i, subnet := range subnetStrings {
+			prefix, err := netip.ParsePrefix(subnet)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("subnets").AtListIndex(i), "Invalid subnet", err.Error())
+				return
+			}
+			subnets = append(subnets, prefix)
+		}
+	}
+
+	ipReservationMutex.Lock()
+	defer ipReservationMutex.Unlock()
+
+	records, err := r.client.CreateHostRecords(fqdn, address, subnets)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating host", err.Error())
+		return
+	}
+
+	state := HostRecordsToHostModel(records)
+	state.Subnet = plan.Subnet
+	state.Subnets = plan.Subnets
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only happens when subnet or subnets is added to a host that did not have them and already has one address inside each of them,
+// every other change replaces the host.
+func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hostModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *hostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hostModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	reusedIDs, diags := hostReusedIpBlockIDs(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteHostRecords(state.ID.ValueInt64(), reusedIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting host",
+			"Could not delete host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts the numeric ID of the host's RR or its FQDN (web01.uoregon.edu)
+func (r *hostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	rr, err := r.client.GetRRByFQDN(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing host", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rr.ID)...)
+}
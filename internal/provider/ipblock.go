@@ -3,12 +3,14 @@ package provider
 import (
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
+	"time"
 
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -42,6 +44,39 @@ type ipblockModel struct {
 	OwnerID             types.Int64  `tfsdk:"owner_id"`
 }
 
+// ipblockResourceModel is ipblockModel plus the settings only the resource has
+type ipblockResourceModel struct {
+	ipblockModel
+	OnDestroy               types.String `tfsdk:"on_destroy"`
+	ReverseZoneID           types.Int64  `tfsdk:"reverse_zone_id"`
+	CreateReverseZone       types.Bool   `tfsdk:"create_reverse_zone"`
+	AllowBroaderReverseZone types.Bool   `tfsdk:"allow_broader_reverse_zone"`
+	ReverseZoneCreated      types.Bool   `tfsdk:"reverse_zone_created"`
+	ReverseZoneLinked       types.Bool   `tfsdk:"reverse_zone_linked"`
+	DeriveFromMAC           types.String `tfsdk:"derive_from_mac"`
+	DeriveFromName          types.String `tfsdk:"derive_from_name"`
+	ReservedUntil           types.String `tfsdk:"reserved_until"`
+}
+
+// what the ipblock resource does to the netdot block when it is destroyed
+const (
+	ipblockOnDestroyDelete  = "delete"
+	ipblockOnDestroyRelease = "release"
+	ipblockOnDestroyAbandon = "abandon"
+)
+
+var ipblockOnDestroyModes = map[string]bool{
+	ipblockOnDestroyDelete:  true,
+	ipblockOnDestroyRelease: true,
+	ipblockOnDestroyAbandon: true,
+}
+
+// ipblockDataSourceModel is ipblockModel plus the lookup only the data source has
+type ipblockDataSourceModel struct {
+	ipblockModel
+	Containing types.String `tfsdk:"containing"`
+}
+
 func IPBlockToIpblockModel(ipblock models.IpBlock) ipblockModel {
 	ipblockModel := ipblockModel{}
 
@@ -86,10 +121,17 @@ func IPBlockModelToIPBlockQuery(model ipblockModel) netdot.IpBlockQuery {
 	queryBuilder := netdot.NewIpBlockQueryBuilder()
 
 	if !model.Address.IsNull() && !model.Address.IsUnknown() {
-		queryBuilder.Address(model.Address.ValueString())
+		// CIDR notation is split into the address and prefix netdot expects
+		prefix, isCIDR, err := netdot.ParseAddressOrPrefix(model.Address.ValueString())
+		if err == nil && isCIDR {
+			queryBuilder.Address(prefix.Addr().String())
+			queryBuilder.Prefix(int64(prefix.Bits()))
+		} else {
+			queryBuilder.Address(model.Address.ValueString())
+		}
 	}
 
-	if !model.Prefix.IsNull() && !model.Prefix.IsUnknown() {
+	if !model.Prefix.IsNull() && !model.Prefix.IsUnknown() && queryBuilder.Build().Prefix == nil {
 		queryBuilder.Prefix(model.Prefix.ValueInt64())
 	}
 
@@ -148,6 +190,83 @@ func IPBlockModelToIPBlockQuery(model ipblockModel) netdot.IpBlockQuery {
 	return queryBuilder.Build()
 }
 
+// statuses that only make sense for a single address, and those that only make sense for a network
+var (
+	ipblockHostStatuses    = map[string]bool{"Static": true, "Dhcp": true}
+	ipblockNetworkStatuses = map[string]bool{"Subnet": true, "Container": true}
+)
+
+// check a planned status against the statuses netdot knows about, so fork specific ones like Dhcp are accepted
+func validateIpblockStatus(client *netdot.Client, status types.String, diags *diag.Diagnostics) {
+	if client == nil || !isPopulated(status) {
+		return
+	}
+
+	err := client.ValidateIpBlockStatus(status.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("status"), "Invalid status", err.Error())
+	}
+}
+
+// keep the address in CIDR notation when that is how it was written and netdot still agrees with it,
+// netdot itself always returns the bare address
+func preserveAddressNotation(prior types.String, model *ipblockModel) {
+	if !isPopulated(prior) || !isPopulated(model.Address) {
+		return
+	}
+
+	prefix, isCIDR, err := netdot.ParseAddressOrPrefix(prior.ValueString())
+	if err != nil || !isCIDR {
+		return
+	}
+
+	if prefix.Addr().String() == model.Address.ValueString() && int64(prefix.Bits()) == model.Prefix.ValueInt64() {
+		model.Address = prior
+	}
+}
+
+// the configured reserved_until as a time, nil when it is not set
+func plannedReservedUntil(model ipblockResourceModel) (*time.Time, error) {
+	if !isPopulated(model.ReservedUntil) {
+		return nil, nil
+	}
+
+	until, err := time.Parse(time.RFC3339, model.ReservedUntil.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &until, nil
+}
+
+// move the reservation marker netdot keeps in info over to reserved_until,
+// an unchanged timestamp keeps the notation it was written in
+func splitReservedUntil(prior types.String, model *ipblockResourceModel) error {
+	until, rest, err := netdot.ParseReservedUntil(model.Info.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if !model.Info.IsNull() {
+		model.Info = autoNullString(rest)
+	}
+
+	if until == nil {
+		model.ReservedUntil = types.StringNull()
+		return nil
+	}
+
+	if isPopulated(prior) {
+		priorUntil, err := time.Parse(time.RFC3339, prior.ValueString())
+		if err == nil && priorUntil.Equal(*until) {
+			model.ReservedUntil = prior
+			return nil
+		}
+	}
+
+	model.ReservedUntil = types.StringValue(until.Format(time.RFC3339))
+	return nil
+}
+
 var ipblockResourceSchema = resourceSchema.Schema{
 	Description: "Resource the represets IPs, subnets, and containers in Netdot.",
 	Attributes: map[string]resourceSchema.Attribute{
@@ -167,19 +286,17 @@ var ipblockResourceSchema = resourceSchema.Schema{
 			},
 		},
 		"prefix": resourceSchema.Int64Attribute{
-			Description: "The prefix length of the IP block.",
+			Description: "The prefix length of the IP block. Inferred from address when not set.",
 			Optional:    true,
 			Computed:    true,
-			Default:     int64default.StaticInt64(32),
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
 		"version": resourceSchema.Int64Attribute{
-			Description: "IP version of the block (4 or 6).",
+			Description: "IP version of the block (4 or 6). Inferred from address when not set.",
 			Optional:    true,
 			Computed:    true,
-			Default:     int64default.StaticInt64(4),
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
 			},
@@ -187,7 +304,7 @@ var ipblockResourceSchema = resourceSchema.Schema{
 		"status": resourceSchema.StringAttribute{
 			Optional:    true,
 			Computed:    true,
-			Description: "Static | Reserved | Available | Subnet | Container",
+			Description: "Static | Reserved | Available | Subnet | Container, or any other status in the IpblockStatus table of the netdot server.",
 			Default:     stringdefault.StaticString("Static"),
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
@@ -302,6 +419,64 @@ var ipblockResourceSchema = resourceSchema.Schema{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
+		"on_destroy": resourceSchema.StringAttribute{
+			Description: "What to do with the block in netdot when the resource is destroyed: delete | release | abandon. release sets the status to Available and clears description, owner and used_by, keeping the address history. abandon only removes the block from state.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(ipblockOnDestroyDelete),
+		},
+		"reverse_zone_id": resourceSchema.Int64Attribute{
+			Description: "ID of the reverse DNS zone PTR records of a Subnet block are created in, linked through netdot's SubnetZone table.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"create_reverse_zone": resourceSchema.BoolAttribute{
+			Description: "Find or create the in-addr.arpa or ip6.arpa zone of a Subnet block and link it as reverse_zone_id. The zone is on the nearest octet (IPv4) or nibble (IPv6) boundary containing the subnet. A zone created this way is deleted with the block once no other subnet is linked to it.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"allow_broader_reverse_zone": resourceSchema.BoolAttribute{
+			Description: "Let create_reverse_zone create a zone that covers more than the subnet, e.g. 171.184.in-addr.arpa for a /20. Without it, such a zone must already exist.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"reverse_zone_created": resourceSchema.BoolAttribute{
+			Description: "Whether create_reverse_zone created the reverse zone, in which case destroying the block deletes it.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"reverse_zone_linked": resourceSchema.BoolAttribute{
+			Description: "Whether the link to reverse_zone_id is managed by this block, because reverse_zone_id or create_reverse_zone is configured. Only a managed link is removed when the zone changes or the block is released, a link found in netdot is left alone.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"reserved_until": resourceSchema.StringAttribute{
+			Description: "RFC 3339 timestamp (2026-11-01T00:00:00Z) after which the reservation may be reclaimed, see the netdot_expired_reservations data source. Kept as a `" + netdot.ReservedUntilMarker + "` line in the info field of the block.",
+			Optional:    true,
+		},
+		"derive_from_mac": resourceSchema.StringAttribute{
+			Description: "Instead of taking the next free address, allocate the modified EUI-64 address of this MAC address inside parent_id, which must be an IPv6 /64. Fails when the address is already in use.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"derive_from_name": resourceSchema.StringAttribute{
+			Description: "Instead of taking the next free address, allocate the address derived from a hash of this name (usually the hostname) inside the IPv6 parent_id. Fails when the address is already in use.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 	},
 }
 
@@ -316,6 +491,10 @@ var ipblockDataSourceSchema = datasourceSchema.Schema{
 			Description: "IP or optionally CIDR of ipblock.",
 			Optional:    true,
 		},
+		"containing": datasourceSchema.StringAttribute{
+			Description: "IP address or CIDR to find the most specific Subnet or Container holding, instead of looking the block up by id or address. Use netdot_containing_ipblock to also get the blocks above it.",
+			Optional:    true,
+		},
 		"prefix": datasourceSchema.Int64Attribute{
 			Description: "The prefix length of the IP block.",
 			Computed:    true,
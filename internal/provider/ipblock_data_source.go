@@ -8,6 +8,7 @@ import (
 	"terraform-provider-netdot/internal/netdot/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -58,7 +59,7 @@ func (d *ipblockDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (d *ipblockDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state ipblockModel
+	var state ipblockDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
@@ -66,13 +67,20 @@ func (d *ipblockDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	if state.ID.IsNull() && state.Address.IsNull() {
-		resp.Diagnostics.AddError("ID or Address is required", "ID or Address must be provided")
+	lookups := 0
+	for _, isNull := range []bool{state.ID.IsNull(), state.Address.IsNull(), state.Containing.IsNull()} {
+		if !isNull {
+			lookups++
+		}
+	}
+
+	if lookups == 0 {
+		resp.Diagnostics.AddError("ID, Address or Containing is required", "ID, Address or Containing must be provided")
 		return
 	}
 
-	if !state.ID.IsNull() && !state.Address.IsNull() {
-		resp.Diagnostics.AddError("ID and Address are mutually exclusive", "Only one of ID or Address can be provided")
+	if lookups > 1 {
+		resp.Diagnostics.AddError("ID, Address and Containing are mutually exclusive", "Only one of ID, Address or Containing can be provided")
 		return
 	}
 
@@ -84,6 +92,18 @@ func (d *ipblockDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			resp.Diagnostics.AddError("Error reading IP block", err.Error())
 			return
 		}
+	} else if !state.Containing.IsNull() {
+		prefix, _, err := netdot.ParseAddressOrPrefix(state.Containing.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("containing"), "Invalid address", fmt.Sprintf("containing must be an IP address or CIDR: %s", err))
+			return
+		}
+
+		netdotIpblock, err = d.client.GetContainingIpBlock(prefix)
+		if err != nil {
+			resp.Diagnostics.AddError("Error finding containing IP block", err.Error())
+			return
+		}
 	} else {
 		var ipblockSearchResults struct {
 			IpBlocks []models.IpBlock `xml:"Ipblock"`
@@ -106,7 +126,7 @@ func (d *ipblockDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		netdotIpblock = ipblockSearchResults.IpBlocks[0]
 	}
 
-	state = IPBlockToIpblockModel(netdotIpblock)
+	state.ipblockModel = IPBlockToIpblockModel(netdotIpblock)
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipblockRangeModel struct {
+	ID               types.String `tfsdk:"id"`
+	Start            types.String `tfsdk:"start"`
+	End              types.String `tfsdk:"end"`
+	ParentID         types.Int64  `tfsdk:"parent_id"`
+	Size             types.Int64  `tfsdk:"size"`
+	Status           types.String `tfsdk:"status"`
+	Description      types.String `tfsdk:"description"`
+	OwnerID          types.Int64  `tfsdk:"owner_id"`
+	UsedByID         types.Int64  `tfsdk:"used_by_id"`
+	IpBlockIDs       types.Map    `tfsdk:"ipblock_ids"`
+	ReleaseOnDestroy types.List   `tfsdk:"release_on_destroy"`
+}
+
+// addresses covered by the range's start and end
+func (model ipblockRangeModel) addresses() ([]netip.Addr, error) {
+	start, err := netip.ParseAddr(model.Start.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid start address: %s", err)
+	}
+
+	end, err := netip.ParseAddr(model.End.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid end address: %s", err)
+	}
+
+	return netdot.AddressesInRange(start, end)
+}
+
+// query holding the attributes shared by every ipblock in the range
+func IpblockRangeModelToIPBlockQuery(model ipblockRangeModel) netdot.IpBlockQuery {
+	queryBuilder := netdot.NewIpBlockQueryBuilder()
+
+	if isPopulated(model.Status) {
+		queryBuilder.Status(model.Status.ValueString())
+	}
+
+	if isPopulated(model.Description) {
+		queryBuilder.Description(model.Description.ValueString())
+	}
+
+	if isPopulated(model.OwnerID) {
+		queryBuilder.OwnerID(model.OwnerID.ValueInt64())
+	}
+
+	if isPopulated(model.UsedByID) {
+		queryBuilder.UsedByID(model.UsedByID.ValueInt64())
+	}
+
+	queryBuilder.SkipReserveFirstN(true)
+	queryBuilder.SkipInheritParentOwner(true)
+
+	return queryBuilder.Build()
+}
+
+var ipblockRangeResourceSchema = resourceSchema.Schema{
+	Description: "Reserves a contiguous range of addresses in Netdot, one IP block per address, all sharing a status, description and owner. Set either start and end, or parent_id and size.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			Description: "Identifier of the range, in the form start-end.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"start": resourceSchema.StringAttribute{
+			Description: "First address of the range.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"end": resourceSchema.StringAttribute{
+			Description: "Last address of the range.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"parent_id": resourceSchema.Int64Attribute{
+			Description: "ID of the subnet to allocate the range from, the first run of size free addresses is used.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"size": resourceSchema.Int64Attribute{
+			Description: fmt.Sprintf("Number of addresses in the range, at most %d.", netdot.MaxIpBlockRangeSize),
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"status": resourceSchema.StringAttribute{
+			Description: "Status of every address in the range. Static | Reserved | Dhcp",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("Reserved"),
+		},
+		"description": resourceSchema.StringAttribute{
+			Description: "Description of every address in the range.",
+			Optional:    true,
+		},
+		"owner_id": resourceSchema.Int64Attribute{
+			Description: "ID of the owner of every address in the range.",
+			Optional:    true,
+		},
+		"used_by_id": resourceSchema.Int64Attribute{
+			Description: "ID of entity that uses every address in the range.",
+			Optional:    true,
+		},
+		"ipblock_ids": resourceSchema.MapAttribute{
+			Description: "IDs of the IP blocks in the range, keyed by address.",
+			ElementType: types.Int64Type,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"release_on_destroy": resourceSchema.ListAttribute{
+			Description: "Addresses that were Available IP blocks before the range reserved them. Destroying the range returns them to Available instead of deleting them, the other addresses are deleted.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
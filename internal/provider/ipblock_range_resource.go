@@ -0,0 +1,512 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ipblockRangeResource{}
+	_ resource.ResourceWithValidateConfig = &ipblockRangeResource{}
+	_ resource.ResourceWithModifyPlan     = &ipblockRangeResource{}
+)
+
+func NewIpblockRangeResource() resource.Resource {
+	return &ipblockRangeResource{}
+}
+
+type ipblockRangeResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ipblockRangeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *ipblockRangeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipblock_range"
+}
+
+func (d *ipblockRangeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ipblockRangeResourceSchema
+}
+
+// ValidateConfig makes sure exactly one way of picking the range's addresses is configured.
+func (d *ipblockRangeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ipblockRangeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	byAddress := !config.Start.IsNull() || !config.End.IsNull()
+	byParent := !config.ParentID.IsNull() || !config.Size.IsNull()
+
+	if byAddress && byParent {
+		resp.Diagnostics.AddError("Conflicting range arguments", "Set either start and end, or parent_id and size, not both")
+		return
+	}
+
+	if !byAddress && !byParent {
+		resp.Diagnostics.AddError("Missing range arguments", "Either start and end, or parent_id and size must be set")
+		return
+	}
+
+	if byAddress && (config.Start.IsNull() || config.End.IsNull()) {
+		resp.Diagnostics.AddError("Missing range arguments", "start and end must be set together")
+		return
+	}
+
+	if byParent && (config.ParentID.IsNull() || config.Size.IsNull()) {
+		resp.Diagnostics.AddError("Missing range arguments", "parent_id and size must be set together")
+		return
+	}
+
+	if isPopulated(config.Size) && (config.Size.ValueInt64() <= 0 || config.Size.ValueInt64() > netdot.MaxIpBlockRangeSize) {
+		resp.Diagnostics.AddAttributeError(path.Root("size"), "Invalid size", fmt.Sprintf("size must be between 1 and %d", netdot.MaxIpBlockRangeSize))
+		return
+	}
+
+	if isPopulated(config.Start) && isPopulated(config.End) {
+		if _, err := config.addresses(); err != nil {
+			resp.Diagnostics.AddError("Invalid address range", err.Error())
+			return
+		}
+	}
+}
+
+// ModifyPlan checks the status against netdot, and plans an update when addresses of the range were deleted
+// outside of Terraform so they get recreated.
+func (d *ipblockRangeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ipblockRangeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateIpblockStatus(d.client, plan.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ipblockRangeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if int64(len(state.IpBlockIDs.Elements())) < state.Size.ValueInt64() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ipblock_ids"), types.MapUnknown(types.Int64Type))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("release_on_destroy"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ipblockRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipblockRangeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipblockIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.IpBlockIDs.ElementsAs(ctx, &ipblockIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	releaseOnDestroy, diags := ipblockRangeReleaseOnDestroy(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var missing, drifted []string
+	remainingIDs := map[string]attr.Value{}
+
+	for _, address := range sortedAddresses(ipblockIDs) {
+		var member models.IpBlock
+		httpStatusCode, err := d.client.GetResourceByID("ipblock", ipblockIDs[address], &member)
+		if err != nil {
+			if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+				missing = append(missing, address)
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading IP block", err.Error())
+			return
+		}
+		remainingIDs[ipblockRangeKey(address)] = types.Int64Value(member.ID)
+
+		memberStatus := autoNullXlinkString(member.StatusXlink, member.Status)
+		memberDescription := autoNullString(member.Description)
+		memberOwnerID := autoNullInt64(member.OwnerXlink.ID)
+		memberUsedByID := autoNullInt64(member.UsedByXlink.ID)
+
+		if state.Status.Equal(memberStatus) && state.Description.Equal(memberDescription) && state.OwnerID.Equal(memberOwnerID) && state.UsedByID.Equal(memberUsedByID) {
+			continue
+		}
+
+		// surface the change on the range itself so the next plan puts every address back in line
+		drifted = append(drifted, address)
+		state.Status = memberStatus
+		state.Description = memberDescription
+		state.OwnerID = memberOwnerID
+		state.UsedByID = memberUsedByID
+	}
+
+	if len(remainingIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddWarning(
+			"IP block range members deleted outside of Terraform",
+			"These addresses will be recreated: "+strings.Join(missing, ", "),
+		)
+	}
+
+	if len(drifted) > 0 {
+		resp.Diagnostics.AddWarning(
+			"IP block range members changed outside of Terraform",
+			"These addresses no longer match the range's status, description, owner or used by: "+strings.Join(drifted, ", "),
+		)
+	}
+
+	ipblockIDsValue, diags := types.MapValue(types.Int64Type, remainingIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IpBlockIDs = ipblockIDsValue
+
+	// an address deleted outside of Terraform is reserved from scratch when it is recreated
+	remainingReleased := map[string]bool{}
+	for address := range releaseOnDestroy {
+		if _, ok := remainingIDs[address]; ok {
+			remainingReleased[address] = true
+		}
+	}
+	state.ReleaseOnDestroy, diags = ipblockRangeReleaseOnDestroyValue(ctx, remainingReleased)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipblockRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipblockRangeModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipReservationMutex.Lock()
+	defer ipReservationMutex.Unlock()
+
+	if !plan.ParentID.IsNull() {
+		start, end, err := r.client.GetAvailableRange(plan.ParentID.ValueInt64(), int(plan.Size.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error finding available range", err.Error())
+			return
+		}
+		plan.Start = types.StringValue(start.String())
+		plan.End = types.StringValue(end.String())
+	}
+
+	addresses, err := plan.addresses()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid address range", err.Error())
+		return
+	}
+
+	reserved, previouslyAvailable, err := r.client.ReserveIpBlockRange(addresses, IpblockRangeModelToIPBlockQuery(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating IP block range", err.Error())
+		return
+	}
+
+	ipblockIDs := map[string]attr.Value{}
+	for _, ipblock := range reserved {
+		ipblockIDs[ipblockRangeKey(ipblock.Address)] = types.Int64Value(ipblock.ID)
+	}
+
+	ipblockIDsValue, diags := types.MapValue(types.Int64Type, ipblockIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	releaseOnDestroy := map[string]bool{}
+	for _, addr := range previouslyAvailable {
+		releaseOnDestroy[addr.String()] = true
+	}
+	plan.ReleaseOnDestroy, diags = ipblockRangeReleaseOnDestroyValue(ctx, releaseOnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Start.ValueString() + "-" + plan.End.ValueString())
+	plan.Size = types.Int64Value(int64(len(addresses)))
+	plan.IpBlockIDs = ipblockIDsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipblockRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ipblockRangeModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state ipblockRangeModel
+
+	diags = req.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateIDs := map[string]int64{}
+	resp.Diagnostics.Append(current_state.IpBlockIDs.ElementsAs(ctx, &stateIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existingIDs := make(map[string]int64, len(stateIDs))
+	for address, id := range stateIDs {
+		existingIDs[ipblockRangeKey(address)] = id
+	}
+
+	releaseOnDestroy, diags := ipblockRangeReleaseOnDestroy(ctx, current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addresses, err := current_state.addresses()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid address range", err.Error())
+		return
+	}
+
+	template := IpblockRangeModelToIPBlockQuery(plan)
+	ipblockIDs := map[string]attr.Value{}
+	var missing []netip.Addr
+
+	for _, addr := range addresses {
+		id, ok := existingIDs[addr.String()]
+		if !ok {
+			delete(releaseOnDestroy, addr.String())
+			missing = append(missing, addr)
+			continue
+		}
+
+		var ipblock models.IpBlock
+		err := r.client.UpdateResource("ipblock", id, netdot.HostIpBlockQuery(template, addr), &ipblock)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating IP block range", fmt.Sprintf("Error updating %s: %s", addr, err))
+			return
+		}
+		ipblockIDs[addr.String()] = types.Int64Value(ipblock.ID)
+	}
+
+	if len(missing) > 0 {
+		ipReservationMutex.Lock()
+		defer ipReservationMutex.Unlock()
+
+		reserved, previouslyAvailable, err := r.client.ReserveIpBlockRange(missing, template)
+		if err != nil {
+			resp.Diagnostics.AddError("Error recreating IP block range members", err.Error())
+			return
+		}
+
+		for _, ipblock := range reserved {
+			ipblockIDs[ipblockRangeKey(ipblock.Address)] = types.Int64Value(ipblock.ID)
+		}
+		for _, addr := range previouslyAvailable {
+			releaseOnDestroy[addr.String()] = true
+		}
+	}
+
+	plan.ReleaseOnDestroy, diags = ipblockRangeReleaseOnDestroyValue(ctx, releaseOnDestroy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipblockIDsValue, diags := types.MapValue(types.Int64Type, ipblockIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IpBlockIDs = ipblockIDsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipblockRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipblockRangeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipblockIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.IpBlockIDs.ElementsAs(ctx, &ipblockIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	releaseOnDestroy, diags := ipblockRangeReleaseOnDestroy(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, address := range sortedAddresses(ipblockIDs) {
+		// a previous partially failed destroy may have removed some addresses already
+		var member models.IpBlock
+		httpStatusCode, err := r.client.GetResourceByID("ipblock", ipblockIDs[address], &member)
+		if err != nil {
+			if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Deleting IP block",
+				fmt.Sprintf("Could not read %s, unexpected error: %s", address, err),
+			)
+			return
+		}
+
+		// blocks that were Available before the range reserved them are handed back the way a failed create does
+		if releaseOnDestroy[ipblockRangeKey(address)] {
+			err = r.client.ReleaseIpBlock(ipblockIDs[address])
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Releasing IP block",
+					fmt.Sprintf("Could not release %s, unexpected error: %s", address, err),
+				)
+			}
+			continue
+		}
+
+		err = r.client.DeleteResourceByID("ipblock", ipblockIDs[address], nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting IP block",
+				fmt.Sprintf("Could not delete %s, unexpected error: %s", address, err),
+			)
+		}
+	}
+}
+
+// ipblock_ids is keyed by the parsed address, so the notation netdot returns for an IPv6 address
+// matches the one the range's addresses are generated in
+func ipblockRangeKey(address string) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+	return addr.String()
+}
+
+// addresses of the range that are released rather than deleted on destroy, keyed like ipblock_ids
+func ipblockRangeReleaseOnDestroy(ctx context.Context, model ipblockRangeModel) (map[string]bool, diag.Diagnostics) {
+	var addresses []string
+	diags := model.ReleaseOnDestroy.ElementsAs(ctx, &addresses, false)
+
+	releaseOnDestroy := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		releaseOnDestroy[ipblockRangeKey(address)] = true
+	}
+
+	return releaseOnDestroy, diags
+}
+
+func ipblockRangeReleaseOnDestroyValue(ctx context.Context, releaseOnDestroy map[string]bool) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.StringType, sortedAddresses(releaseOnDestroy))
+}
+
+// keys of an address keyed map in address order
+func sortedAddresses[V any](byAddress map[string]V) []string {
+	addresses := make([]string, 0, len(byAddress))
+	for address := range byAddress {
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		a, errA := netip.ParseAddr(addresses[i])
+		b, errB := netip.ParseAddr(addresses[j])
+		if errA != nil || errB != nil {
+			return addresses[i] < addresses[j]
+		}
+		return a.Less(b)
+	})
+
+	return addresses
+}
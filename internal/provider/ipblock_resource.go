@@ -4,21 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"strconv"
+	"strings"
 	"sync"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var ipReservationMutex sync.Mutex
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ipblockResource{}
-	_ resource.ResourceWithImportState = &ipblockResource{}
+	_ resource.Resource                   = &ipblockResource{}
+	_ resource.ResourceWithImportState    = &ipblockResource{}
+	_ resource.ResourceWithValidateConfig = &ipblockResource{}
+	_ resource.ResourceWithModifyPlan     = &ipblockResource{}
 )
 
 // NewIpblockResource is a helper function to simplify the provider implementation.
@@ -61,9 +66,195 @@ func (d *ipblockResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = ipblockResourceSchema
 }
 
+// ValidateConfig catches address, prefix, version and status combinations netdot would reject at apply time.
+func (d *ipblockResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ipblockResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isPopulated(config.OnDestroy) && !ipblockOnDestroyModes[config.OnDestroy.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("on_destroy"), "Invalid on_destroy", fmt.Sprintf("on_destroy must be one of delete, release or abandon, got %q", config.OnDestroy.ValueString()))
+		return
+	}
+
+	if isPopulated(config.ReverseZoneID) && config.CreateReverseZone.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("create_reverse_zone"), "Conflicting reverse zone", "create_reverse_zone can not be used together with reverse_zone_id")
+		return
+	}
+
+	if (isPopulated(config.ReverseZoneID) || config.CreateReverseZone.ValueBool()) && !config.Status.IsUnknown() && config.Status.ValueString() != "Subnet" {
+		resp.Diagnostics.AddAttributeError(path.Root("reverse_zone_id"), "Reverse zone requires a subnet", "reverse_zone_id and create_reverse_zone can only be used on blocks with status Subnet")
+		return
+	}
+
+	if config.CreateReverseZone.ValueBool() && !config.AllowBroaderReverseZone.ValueBool() && isPopulated(config.Address) {
+		prefix, isCIDR, err := netdot.ParseAddressOrPrefix(config.Address.ValueString())
+		if err == nil && isCIDR && !netdot.ReverseZoneMatchesPrefix(prefix) {
+			resp.Diagnostics.AddAttributeError(path.Root("create_reverse_zone"), "Reverse zone broader than the subnet", fmt.Sprintf("the reverse zone of %s is %s, which also covers addresses outside of it. Set allow_broader_reverse_zone to let it be created, or link an existing zone with reverse_zone_id", prefix.Masked(), netdot.ReverseZoneName(prefix)))
+			return
+		}
+	}
+
+	if !config.DeriveFromMAC.IsNull() || !config.DeriveFromName.IsNull() {
+		if !config.DeriveFromMAC.IsNull() && !config.DeriveFromName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("derive_from_name"), "Conflicting address derivation", "only one of derive_from_mac and derive_from_name can be set")
+			return
+		}
+
+		if !config.Address.IsNull() || config.ParentID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("parent_id"), "Address derivation requires a parent", "derive_from_mac and derive_from_name need parent_id and can not be used together with address")
+			return
+		}
+	}
+
+	if isPopulated(config.ReservedUntil) {
+		if _, err := plannedReservedUntil(config); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reserved_until"), "Invalid reserved_until", fmt.Sprintf("reserved_until must be an RFC 3339 timestamp such as 2026-11-01T00:00:00Z: %s", err))
+			return
+		}
+	}
+
+	if isPopulated(config.Info) && strings.Contains(config.Info.ValueString(), netdot.ReservedUntilMarker) {
+		resp.Diagnostics.AddAttributeError(path.Root("info"), "Reserved info marker", fmt.Sprintf("info can not contain %q, use reserved_until instead", netdot.ReservedUntilMarker))
+		return
+	}
+
+	if isPopulated(config.Version) && config.Version.ValueInt64() != 4 && config.Version.ValueInt64() != 6 {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid IP version", "version must be 4 or 6")
+		return
+	}
+
+	if isPopulated(config.Prefix) && (config.Prefix.ValueInt64() < 0 || config.Prefix.ValueInt64() > 128) {
+		resp.Diagnostics.AddAttributeError(path.Root("prefix"), "Invalid prefix", "prefix must be between 0 and 128")
+		return
+	}
+
+	if !isPopulated(config.Address) {
+		return
+	}
+
+	prefix, isCIDR, err := netdot.ParseAddressOrPrefix(config.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("address"), "Invalid address", fmt.Sprintf("address must be an IP address or CIDR: %s", err))
+		return
+	}
+	addr := prefix.Addr()
+
+	if isCIDR && isPopulated(config.Prefix) && config.Prefix.ValueInt64() != int64(prefix.Bits()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix"),
+			"Conflicting prefix",
+			fmt.Sprintf("address %s already sets a prefix length of %d, but prefix is %d", config.Address.ValueString(), prefix.Bits(), config.Prefix.ValueInt64()),
+		)
+		return
+	}
+
+	bits := int64(prefix.Bits())
+	if !isCIDR && !config.Prefix.IsNull() {
+		if config.Prefix.IsUnknown() {
+			return
+		}
+		bits = config.Prefix.ValueInt64()
+	}
+
+	if bits > int64(addr.BitLen()) {
+		resp.Diagnostics.AddAttributeError(path.Root("prefix"), "Invalid prefix", fmt.Sprintf("prefix must be at most %d for IPv%d addresses", addr.BitLen(), netdot.IpBlockVersion(addr)))
+		return
+	}
+
+	if isPopulated(config.Version) && config.Version.ValueInt64() != netdot.IpBlockVersion(addr) {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Conflicting IP version", fmt.Sprintf("address %s is an IPv%d address, but version is %d", addr, netdot.IpBlockVersion(addr), config.Version.ValueInt64()))
+		return
+	}
+
+	network := netip.PrefixFrom(addr, int(bits))
+	if network.Masked().Addr() != addr {
+		resp.Diagnostics.AddAttributeError(path.Root("address"), "Host bits set", fmt.Sprintf("%s has host bits set, did you mean %s?", network, network.Masked()))
+		return
+	}
+
+	if config.Status.IsUnknown() {
+		return
+	}
+
+	// mirrors the schema default
+	status := "Static"
+	if !config.Status.IsNull() {
+		status = config.Status.ValueString()
+	}
+
+	isHost := bits == int64(addr.BitLen())
+	if ipblockHostStatuses[status] && !isHost {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Status does not fit prefix", fmt.Sprintf("a %s block must be a single address, but %s is a network; use Subnet or Container for networks", status, network))
+		return
+	}
+
+	if ipblockNetworkStatuses[status] && isHost {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Status does not fit prefix", fmt.Sprintf("a %s block must be a network, but %s is a single address", status, network))
+		return
+	}
+}
+
+// ModifyPlan checks the status against netdot and infers prefix and version from the configured address when they are not set.
+func (d *ipblockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ipblockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateIpblockStatus(d.client, plan.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config ipblockResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the zone is only known once it has been found or created
+	if config.CreateReverseZone.ValueBool() && config.ReverseZoneID.IsNull() && plan.ReverseZoneID.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reverse_zone_id"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reverse_zone_created"), types.BoolUnknown())...)
+	}
+
+	// a configured zone is linked by this block, an unconfigured one keeps whatever link it had
+	if !config.ReverseZoneID.IsNull() || config.CreateReverseZone.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reverse_zone_linked"), types.BoolValue(true))...)
+	} else if plan.ReverseZoneLinked.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reverse_zone_linked"), types.BoolValue(false))...)
+	}
+
+	if !isPopulated(config.Address) {
+		return
+	}
+
+	prefix, _, err := netdot.ParseAddressOrPrefix(config.Address.ValueString())
+	if err != nil {
+		return
+	}
+
+	if config.Prefix.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("prefix"), types.Int64Value(int64(prefix.Bits())))...)
+	}
+
+	if config.Version.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Value(netdot.IpBlockVersion(prefix.Addr())))...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *ipblockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state ipblockModel
+	var state ipblockResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -85,7 +276,49 @@ func (d *ipblockResource) Read(ctx context.Context, req resource.ReadRequest, re
 		}
 	}
 
-	state = IPBlockToIpblockModel(netdotIpblock)
+	priorAddress := state.Address
+	priorReservedUntil := state.ReservedUntil
+	state.ipblockModel = IPBlockToIpblockModel(netdotIpblock)
+	preserveAddressNotation(priorAddress, &state.ipblockModel)
+
+	err := splitReservedUntil(priorReservedUntil, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block", err.Error())
+		return
+	}
+
+	// imported blocks have no on_destroy yet
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(ipblockOnDestroyDelete)
+	}
+
+	if state.CreateReverseZone.IsNull() {
+		state.CreateReverseZone = types.BoolValue(false)
+	}
+
+	if state.AllowBroaderReverseZone.IsNull() {
+		state.AllowBroaderReverseZone = types.BoolValue(false)
+	}
+
+	if state.ReverseZoneCreated.IsNull() {
+		state.ReverseZoneCreated = types.BoolValue(false)
+	}
+
+	if state.ReverseZoneLinked.IsNull() {
+		state.ReverseZoneLinked = types.BoolValue(false)
+	}
+
+	reverseZoneID, err := d.readReverseZone(netdotIpblock, state.ReverseZoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading reverse zone", err.Error())
+		return
+	}
+	if !reverseZoneID.Equal(state.ReverseZoneID) {
+		// a zone linked outside of Terraform was neither created nor linked by this block
+		state.ReverseZoneCreated = types.BoolValue(false)
+		state.ReverseZoneLinked = types.BoolValue(false)
+	}
+	state.ReverseZoneID = reverseZoneID
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -96,7 +329,7 @@ func (d *ipblockResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *ipblockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ipblockModel
+	var plan ipblockResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -104,14 +337,21 @@ func (r *ipblockResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var config ipblockModel
+	var config ipblockResourceModel
 	diags = req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	queryBuilder := IPBlockModelToIPBlockQuery(plan).Builder()
+	reservedUntil, err := plannedReservedUntil(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating IP block", err.Error())
+		return
+	}
+
+	queryBuilder := IPBlockModelToIPBlockQuery(plan.ipblockModel).Builder()
+	queryBuilder.Info(netdot.SetReservedUntil(plan.Info.ValueString(), reservedUntil))
 
 	queryBuilder.SkipReserveFirstN(true)
 	queryBuilder.SkipInheritParentOwner(true)
@@ -123,12 +363,33 @@ func (r *ipblockResource) Create(ctx context.Context, req resource.CreateRequest
 	if config.Address.IsNull() && !config.ParentID.IsNull() {
 		ipReservationMutex.Lock()
 		defer ipReservationMutex.Unlock()
-		existingIpID, addr, err := r.client.GetNextAvailableIP(config.ParentID.ValueInt64(), netdot.IPAllocationStrategyFirstFree)
-		if err != nil {
-			resp.Diagnostics.AddError("Error getting next available IP", err.Error())
-			return
+		var existingIpID *int64
+		var allocated netip.Addr
+		if isPopulated(config.DeriveFromMAC) || isPopulated(config.DeriveFromName) {
+			allocated, err = r.deriveAddress(config)
+			if err != nil {
+				resp.Diagnostics.AddError("Error deriving IP address", err.Error())
+				return
+			}
+			existingIpID, err = r.client.CheckAddressFree(allocated)
+			if err != nil {
+				resp.Diagnostics.AddError("Error deriving IP address", err.Error())
+				return
+			}
+		} else {
+			var addr string
+			existingIpID, addr, err = r.client.GetNextAvailableIP(config.ParentID.ValueInt64(), netdot.IPAllocationStrategyFirstFree)
+			if err != nil {
+				resp.Diagnostics.AddError("Error getting next available IP", err.Error())
+				return
+			}
+			allocated, err = netip.ParseAddr(addr)
+			if err != nil {
+				resp.Diagnostics.AddError("Error getting next available IP", err.Error())
+				return
+			}
 		}
-		createQuery.Address = &addr
+		createQuery = netdot.HostIpBlockQuery(createQuery, allocated)
 		if existingIpID == nil {
 			err = r.client.CreateResource("ipblock", createQuery, &newIPBlock)
 			if err != nil {
@@ -143,14 +404,31 @@ func (r *ipblockResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 		}
 	} else {
-		err := r.client.CreateResource("ipblock", createQuery, &newIPBlock)
+		err = r.client.CreateResource("ipblock", createQuery, &newIPBlock)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating IP block", err.Error())
 			return
 		}
 	}
 
-	state := IPBlockToIpblockModel(newIPBlock)
+	state := plan
+	state.ipblockModel = IPBlockToIpblockModel(newIPBlock)
+	preserveAddressNotation(plan.Address, &state.ipblockModel)
+	err = splitReservedUntil(plan.ReservedUntil, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block", err.Error())
+		return
+	}
+
+	reverseZone, err := r.syncReverseZone(newIPBlock, plan, reverseZoneLink{ID: types.Int64Null()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error linking reverse zone", err.Error())
+		// the block exists, keep it in state so it is not orphaned
+		reverseZone = reverseZoneLink{ID: types.Int64Null()}
+	}
+	state.ReverseZoneID = reverseZone.ID
+	state.ReverseZoneCreated = types.BoolValue(reverseZone.Created)
+	state.ReverseZoneLinked = types.BoolValue(reverseZone.Linked)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -160,7 +438,7 @@ func (r *ipblockResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *ipblockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ipblockModel
+	var plan ipblockResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -168,7 +446,7 @@ func (r *ipblockResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var current_state ipblockModel
+	var current_state ipblockResourceModel
 
 	diags = resp.State.Get(ctx, &current_state)
 	resp.Diagnostics.Append(diags...)
@@ -176,19 +454,47 @@ func (r *ipblockResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updateQueryBuilder := IPBlockModelToIPBlockQuery(plan).Builder()
+	reservedUntil, err := plannedReservedUntil(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating IP block", err.Error())
+		return
+	}
+
+	updateQueryBuilder := IPBlockModelToIPBlockQuery(plan.ipblockModel).Builder()
+	updateQueryBuilder.Info(netdot.SetReservedUntil(plan.Info.ValueString(), reservedUntil))
 	updateQueryBuilder.SkipReserveFirstN(true)
 
 	updateQuery := updateQueryBuilder.Build()
 
 	var ipblock models.IpBlock
-	err := r.client.UpdateResource("ipblock", plan.ID.ValueInt64(), updateQuery, &ipblock)
+	err = r.client.UpdateResource("ipblock", plan.ID.ValueInt64(), updateQuery, &ipblock)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating IP block", err.Error())
 		return
 	}
 
-	state := IPBlockToIpblockModel(ipblock)
+	state := plan
+	state.ipblockModel = IPBlockToIpblockModel(ipblock)
+	preserveAddressNotation(plan.Address, &state.ipblockModel)
+	err = splitReservedUntil(plan.ReservedUntil, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block", err.Error())
+		return
+	}
+
+	prior := reverseZoneLink{
+		ID:      current_state.ReverseZoneID,
+		Created: current_state.ReverseZoneCreated.ValueBool(),
+		Linked:  current_state.ReverseZoneLinked.ValueBool(),
+	}
+	reverseZone, err := r.syncReverseZone(ipblock, plan, prior)
+	if err != nil {
+		resp.Diagnostics.AddError("Error linking reverse zone", err.Error())
+		reverseZone = prior
+	}
+	state.ReverseZoneID = reverseZone.ID
+	state.ReverseZoneCreated = types.BoolValue(reverseZone.Created)
+	state.ReverseZoneLinked = types.BoolValue(reverseZone.Linked)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -198,7 +504,7 @@ func (r *ipblockResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *ipblockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state ipblockModel
+	var state ipblockResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -206,20 +512,191 @@ func (r *ipblockResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteResourceByID("ipblock", state.ID.ValueInt64(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting IP block", err.Error())
+	switch state.OnDestroy.ValueString() {
+	case ipblockOnDestroyAbandon:
 		return
+	case ipblockOnDestroyRelease:
+		err := r.client.ReleaseIpBlock(state.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error releasing IP block", err.Error())
+			return
+		}
+		// a link this block did not make belongs to whoever made it
+		if state.ReverseZoneLinked.ValueBool() && isPopulated(state.ReverseZoneID) {
+			err = r.client.RemoveSubnetZone(state.ID.ValueInt64(), state.ReverseZoneID.ValueInt64())
+			if err != nil {
+				resp.Diagnostics.AddError("Error unlinking reverse zone", err.Error())
+				return
+			}
+		}
+	default:
+		err := r.client.DeleteResourceByID("ipblock", state.ID.ValueInt64(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting IP block", err.Error())
+			return
+		}
+	}
+
+	if state.ReverseZoneCreated.ValueBool() && isPopulated(state.ReverseZoneID) {
+		deleted, err := r.client.DeleteZoneUnlessLinked(state.ReverseZoneID.ValueInt64(), state.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting reverse zone", err.Error())
+			return
+		}
+		if !deleted {
+			resp.Diagnostics.AddWarning("Reverse zone kept", fmt.Sprintf("Zone %d was created for this block but other subnets are linked to it now, so it was left in place", state.ReverseZoneID.ValueInt64()))
+		}
 	}
 }
 
+// ImportState accepts the numeric ID of the block, an address (10.1.2.3) or a CIDR (10.1.2.0/24, 2001:db8::/64)
 func (r *ipblockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	// resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	prefix, _, err := netdot.ParseAddressOrPrefix(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID, an IP address or a CIDR, got %q: %s", req.ID, err))
+		return
+	}
+
+	if prefix.Masked() != prefix {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("%s has host bits set, did you mean %s?", prefix, prefix.Masked()))
+		return
+	}
+
+	ipblock, err := r.client.GetIpBlockByPrefix(prefix)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err))
+		resp.Diagnostics.AddError("Error importing IP block", fmt.Sprintf("Could not resolve %s to a single IP block: %s", req.ID, err))
 		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ipblock.ID)...)
+}
+
+// reverseZoneLink is the reverse zone of a block and what the block did to get it
+type reverseZoneLink struct {
+	ID      types.Int64
+	Created bool // the zone was created for the block
+	Linked  bool // the SubnetZone link is managed by the block
+}
+
+// link the block to its planned reverse zone, finding or creating the zone when create_reverse_zone is set.
+// A zone that is neither configured nor created is only tracked, its link is left as found.
+// A previously linked zone that is no longer wanted is unlinked when this block linked it, and deleted when this block created it.
+func (r *ipblockResource) syncReverseZone(ipblock models.IpBlock, plan ipblockResourceModel, prior reverseZoneLink) (reverseZoneLink, error) {
+	zoneID := plan.ReverseZoneID
+	if !isPopulated(zoneID) {
+		zoneID = types.Int64Null()
+	}
+	created := prior.Created && zoneID.Equal(prior.ID)
+	linked := plan.ReverseZoneLinked.ValueBool() || (prior.Linked && zoneID.Equal(prior.ID))
+
+	if zoneID.IsNull() && plan.CreateReverseZone.ValueBool() {
+		prefix, err := netdot.IpBlockPrefix(ipblock)
+		if err != nil {
+			return reverseZoneLink{ID: types.Int64Null()}, err
+		}
+		name := netdot.ReverseZoneName(prefix)
+
+		var zone models.Zone
+		if netdot.ReverseZoneMatchesPrefix(prefix) || plan.AllowBroaderReverseZone.ValueBool() {
+			zone, created, err = r.client.EnsureZone(name)
+		} else {
+			// a zone broader than the subnet is only linked when someone already created it
+			zone, err = r.client.GetZoneByName(name)
+			if err != nil {
+				err = fmt.Errorf("the reverse zone of %s is %s, which also covers addresses outside of it. Set allow_broader_reverse_zone to let it be created: %s", prefix.Masked(), name, err)
+			}
+		}
+		if err != nil {
+			return reverseZoneLink{ID: types.Int64Null()}, err
+		}
+		zoneID = types.Int64Value(zone.ID)
+	}
+
+	if isPopulated(prior.ID) && prior.ID.ValueInt64() != zoneID.ValueInt64() && prior.Linked {
+		err := r.client.RemoveSubnetZone(ipblock.ID, prior.ID.ValueInt64())
+		if err != nil {
+			return prior, err
+		}
+
+		if prior.Created {
+			_, err = r.client.DeleteZoneUnlessLinked(prior.ID.ValueInt64(), ipblock.ID)
+			if err != nil {
+				return reverseZoneLink{ID: zoneID, Created: created, Linked: linked}, err
+			}
+		}
+	}
+
+	if zoneID.IsNull() {
+		return reverseZoneLink{ID: zoneID}, nil
+	}
+
+	if !linked {
+		return reverseZoneLink{ID: zoneID, Created: created}, nil
+	}
+
+	_, err := r.client.EnsureSubnetZone(ipblock.ID, zoneID.ValueInt64())
+	if err != nil {
+		if created {
+			// do not leave a zone behind that nothing points at
+			_, _ = r.client.DeleteZoneUnlessLinked(zoneID.ValueInt64(), ipblock.ID)
+		}
+		return reverseZoneLink{ID: types.Int64Null()}, err
+	}
+
+	return reverseZoneLink{ID: zoneID, Created: created, Linked: true}, nil
+}
+
+// the reverse zone the block is linked to. A known zone is kept while its link exists,
+// otherwise a single linked zone is picked up, as happens after an import.
+func (d *ipblockResource) readReverseZone(ipblock models.IpBlock, prior types.Int64) (types.Int64, error) {
+	if ipblock.Status != "Subnet" {
+		return types.Int64Null(), nil
+	}
+
+	queryBuilder := netdot.NewSubnetZoneQueryBuilder()
+	queryBuilder.Subnet(ipblock.ID)
+	subnetZones, err := d.client.ListSubnetZones(queryBuilder.Build())
+	if err != nil {
+		return prior, err
+	}
+
+	for _, subnetZone := range subnetZones {
+		if subnetZone.ZoneXlink.ID == prior.ValueInt64() {
+			return prior, nil
+		}
+	}
+
+	if len(subnetZones) == 1 {
+		return types.Int64Value(subnetZones[0].ZoneXlink.ID), nil
+	}
+
+	return types.Int64Null(), nil
+}
+
+// the EUI-64 or name hash address the configuration asks for inside the parent block
+func (r *ipblockResource) deriveAddress(config ipblockResourceModel) (netip.Addr, error) {
+	var parent models.IpBlock
+	_, err := r.client.GetResourceByID("ipblock", config.ParentID.ValueInt64(), &parent)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	prefix, err := netdot.IpBlockPrefix(parent)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if isPopulated(config.DeriveFromMAC) {
+		return netdot.EUI64Address(prefix, config.DeriveFromMAC.ValueString())
+	}
+
+	return netdot.StableHashAddress(prefix, config.DeriveFromName.ValueString())
 }
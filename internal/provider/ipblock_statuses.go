@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipblockStatusesModel struct {
+	Statuses []ipblockStatusModel `tfsdk:"statuses"`
+	Names    []types.String       `tfsdk:"names"`
+}
+
+type ipblockStatusModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func IpBlockStatusToIpblockStatusModel(status models.IpBlockStatus) ipblockStatusModel {
+	var finalModel ipblockStatusModel
+
+	finalModel.ID = types.Int64Value(status.ID)
+	finalModel.Name = types.StringValue(status.Name)
+
+	return finalModel
+}
+
+var ipblockStatusesDataSourceSchema = datasourceSchema.Schema{
+	Description: "Every status an IP block can have on the netdot server, including statuses specific to a fork of Netdot.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"statuses": datasourceSchema.ListNestedAttribute{
+			Description: "The IP block statuses.",
+			Computed:    true,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: map[string]datasourceSchema.Attribute{
+					"id": datasourceSchema.Int64Attribute{
+						Description: "ID of the status.",
+						Computed:    true,
+					},
+					"name": datasourceSchema.StringAttribute{
+						Description: "Name of the status, as used in the status attribute of netdot_ipblock.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"names": datasourceSchema.ListAttribute{
+			Description: "Names of the statuses.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	},
+}
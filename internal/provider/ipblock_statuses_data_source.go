@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &ipblockStatusesDataSource{}
+)
+
+func NewIpblockStatusesDataSource() datasource.DataSource {
+	return &ipblockStatusesDataSource{}
+}
+
+type ipblockStatusesDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ipblockStatusesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *ipblockStatusesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipblock_statuses"
+}
+
+func (d *ipblockStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ipblockStatusesDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ipblockStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ipblockStatusesModel
+
+	statuses, err := d.client.GetIpBlockStatuses()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block statuses", err.Error())
+		return
+	}
+
+	state.Statuses = make([]ipblockStatusModel, 0, len(statuses))
+	state.Names = make([]types.String, 0, len(statuses))
+	for _, status := range statuses {
+		state.Statuses = append(state.Statuses, IpBlockStatusToIpblockStatusModel(status))
+		state.Names = append(state.Names, types.StringValue(status.Name))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipblockTreeModel struct {
+	RootID       types.Int64            `tfsdk:"root_id"`
+	Root         types.String           `tfsdk:"root"`
+	MaxDepth     types.Int64            `tfsdk:"max_depth"`
+	IncludeHosts types.Bool             `tfsdk:"include_hosts"`
+	Nodes        []ipblockTreeNodeModel `tfsdk:"nodes"`
+}
+
+type ipblockTreeNodeModel struct {
+	ID          types.Int64   `tfsdk:"id"`
+	Address     types.String  `tfsdk:"address"`
+	Prefix      types.Int64   `tfsdk:"prefix"`
+	CIDR        types.String  `tfsdk:"cidr"`
+	Version     types.Int64   `tfsdk:"version"`
+	Status      types.String  `tfsdk:"status"`
+	Description types.String  `tfsdk:"description"`
+	Owner       types.String  `tfsdk:"owner"`
+	OwnerID     types.Int64   `tfsdk:"owner_id"`
+	VLANID      types.Int64   `tfsdk:"vlan_id"`
+	ParentID    types.Int64   `tfsdk:"parent_id"`
+	Depth       types.Int64   `tfsdk:"depth"`
+	ChildIDs    []types.Int64 `tfsdk:"child_ids"`
+	Total       types.Int64   `tfsdk:"total"`
+	Used        types.Int64   `tfsdk:"used"`
+	Free        types.Int64   `tfsdk:"free"`
+	PercentUsed types.Float64 `tfsdk:"percent_used"`
+}
+
+func IpBlockTreeNodeToIpblockTreeNodeModel(node netdot.IpBlockTreeNode) ipblockTreeNodeModel {
+	var finalModel ipblockTreeNodeModel
+
+	finalModel.ID = types.Int64Value(node.IpBlock.ID)
+	finalModel.Address = types.StringValue(node.IpBlock.Address)
+	finalModel.Prefix = types.Int64Value(node.IpBlock.Prefix)
+	finalModel.CIDR = types.StringValue(fmt.Sprintf("%s/%d", node.IpBlock.Address, node.IpBlock.Prefix))
+	finalModel.Version = types.Int64Value(node.IpBlock.Version)
+	finalModel.Status = autoNullXlinkString(node.IpBlock.StatusXlink, node.IpBlock.Status)
+	finalModel.Description = autoNullString(node.IpBlock.Description)
+	finalModel.Owner = autoNullXlinkString(node.IpBlock.OwnerXlink, node.IpBlock.Owner)
+	finalModel.OwnerID = autoNullInt64(node.IpBlock.OwnerXlink.ID)
+	finalModel.VLANID = autoNullInt64(node.IpBlock.VLANXlink.ID)
+	finalModel.ParentID = autoNullInt64(node.IpBlock.ParentXlink.ID)
+	finalModel.Depth = types.Int64Value(int64(node.Depth))
+
+	finalModel.ChildIDs = make([]types.Int64, 0, len(node.ChildIDs))
+	for _, childID := range node.ChildIDs {
+		finalModel.ChildIDs = append(finalModel.ChildIDs, types.Int64Value(childID))
+	}
+
+	finalModel.Total = types.Int64Value(node.Utilization.Total)
+	finalModel.Used = types.Int64Value(node.Utilization.Used)
+	finalModel.Free = types.Int64Value(node.Utilization.Free)
+	finalModel.PercentUsed = types.Float64Value(node.Utilization.PercentUsed)
+
+	return finalModel
+}
+
+var ipblockTreeDataSourceSchema = datasourceSchema.Schema{
+	Description: "A container or subnet and every block below it, flattened in depth first order. Use depth, parent_id and child_ids to rebuild the hierarchy.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"root_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the IP block at the top of the tree.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"root": datasourceSchema.StringAttribute{
+			Description: "CIDR of the IP block at the top of the tree.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_depth": datasourceSchema.Int64Attribute{
+			Description: "How many levels below the root to return. Unlimited when not set, 0 returns only the root.",
+			Optional:    true,
+		},
+		"include_hosts": datasourceSchema.BoolAttribute{
+			Description: "Whether to return single address blocks. They always count towards utilization.",
+			Optional:    true,
+		},
+		"nodes": datasourceSchema.ListNestedAttribute{
+			Description: "The root followed by its descendants, depth first with siblings ordered by address.",
+			Computed:    true,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: map[string]datasourceSchema.Attribute{
+					"id": datasourceSchema.Int64Attribute{
+						Description: "ID of the IP block.",
+						Computed:    true,
+					},
+					"address": datasourceSchema.StringAttribute{
+						Description: "Address of the IP block.",
+						Computed:    true,
+					},
+					"prefix": datasourceSchema.Int64Attribute{
+						Description: "The prefix length of the IP block.",
+						Computed:    true,
+					},
+					"cidr": datasourceSchema.StringAttribute{
+						Description: "Address and prefix length of the IP block in CIDR notation.",
+						Computed:    true,
+					},
+					"version": datasourceSchema.Int64Attribute{
+						Description: "IP version of the block (4 or 6).",
+						Computed:    true,
+					},
+					"status": datasourceSchema.StringAttribute{
+						Description: "Static | Reserved | Available | Subnet | Container",
+						Computed:    true,
+					},
+					"description": datasourceSchema.StringAttribute{
+						Description: "Description of the IP block.",
+						Computed:    true,
+					},
+					"owner": datasourceSchema.StringAttribute{
+						Description: "Name of the owner associated with the IP block.",
+						Computed:    true,
+					},
+					"owner_id": datasourceSchema.Int64Attribute{
+						Description: "ID of the owner associated with the IP block.",
+						Computed:    true,
+					},
+					"vlan_id": datasourceSchema.Int64Attribute{
+						Description: "ID of VLAN (within the database, not the actual VLAN ID) that this block is associated with.",
+						Computed:    true,
+					},
+					"parent_id": datasourceSchema.Int64Attribute{
+						Description: "ID of the parent IP block.",
+						Computed:    true,
+					},
+					"depth": datasourceSchema.Int64Attribute{
+						Description: "Number of levels below the root, 0 for the root itself.",
+						Computed:    true,
+					},
+					"child_ids": datasourceSchema.ListAttribute{
+						Description: "IDs of the children returned in nodes.",
+						ElementType: types.Int64Type,
+						Computed:    true,
+					},
+					"total": datasourceSchema.Int64Attribute{
+						Description: "Number of usable addresses in the block.",
+						Computed:    true,
+					},
+					"used": datasourceSchema.Int64Attribute{
+						Description: "Number of addresses covered by a child block with any status other than Available.",
+						Computed:    true,
+					},
+					"free": datasourceSchema.Int64Attribute{
+						Description: "Number of addresses that are Available or have no IP block.",
+						Computed:    true,
+					},
+					"percent_used": datasourceSchema.Float64Attribute{
+						Description: "Percentage of usable addresses in use, from 0 to 100.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &ipblockTreeDataSource{}
+)
+
+func NewIpblockTreeDataSource() datasource.DataSource {
+	return &ipblockTreeDataSource{}
+}
+
+type ipblockTreeDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ipblockTreeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *ipblockTreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipblock_tree"
+}
+
+func (d *ipblockTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ipblockTreeDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ipblockTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ipblockTreeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RootID.IsNull() && state.Root.IsNull() {
+		resp.Diagnostics.AddError("Root ID or Root is required", "Root ID or Root must be provided")
+		return
+	}
+
+	if !state.RootID.IsNull() && !state.Root.IsNull() {
+		resp.Diagnostics.AddError("Root ID and Root are mutually exclusive", "Only one of Root ID or Root can be provided")
+		return
+	}
+
+	maxDepth := -1
+	if !state.MaxDepth.IsNull() {
+		if state.MaxDepth.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_depth"), "Invalid max depth", "max_depth must not be negative")
+			return
+		}
+		maxDepth = int(state.MaxDepth.ValueInt64())
+	}
+
+	if state.RootID.IsNull() {
+		prefix, err := netip.ParsePrefix(state.Root.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("root"), "Invalid CIDR", err.Error())
+			return
+		}
+
+		root, err := d.client.GetIpBlockByPrefix(prefix.Masked())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading root IP block", err.Error())
+			return
+		}
+		state.RootID = types.Int64Value(root.ID)
+	}
+
+	nodes, err := d.client.GetIpBlockTree(state.RootID.ValueInt64(), maxDepth, state.IncludeHosts.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block tree", err.Error())
+		return
+	}
+
+	if state.Root.IsNull() {
+		state.Root = types.StringValue(fmt.Sprintf("%s/%d", nodes[0].IpBlock.Address, nodes[0].IpBlock.Prefix))
+	}
+
+	state.Nodes = make([]ipblockTreeNodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		state.Nodes = append(state.Nodes, IpBlockTreeNodeToIpblockTreeNodeModel(node))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
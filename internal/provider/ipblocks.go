@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ipblocksModel struct {
+	Address             types.String   `tfsdk:"address"`
+	Prefix              types.Int64    `tfsdk:"prefix"`
+	Version             types.Int64    `tfsdk:"version"`
+	Status              types.String   `tfsdk:"status"`
+	ASNID               types.Int64    `tfsdk:"asn_id"`
+	Description         types.String   `tfsdk:"description"`
+	Info                types.String   `tfsdk:"info"`
+	InterfaceID         types.Int64    `tfsdk:"interface_id"`
+	Monitored           types.Bool     `tfsdk:"monitored"`
+	OwnerID             types.Int64    `tfsdk:"owner_id"`
+	ParentID            types.Int64    `tfsdk:"parent_id"`
+	RIR                 types.String   `tfsdk:"rir"`
+	UseNetworkBroadcast types.Bool     `tfsdk:"use_network_broadcast"`
+	UsedByID            types.Int64    `tfsdk:"used_by_id"`
+	VLANID              types.Int64    `tfsdk:"vlan_id"`
+	MinPrefix           types.Int64    `tfsdk:"min_prefix"`
+	MaxPrefix           types.Int64    `tfsdk:"max_prefix"`
+	Within              types.String   `tfsdk:"within"`
+	IpBlocks            []ipblockModel `tfsdk:"ipblocks"`
+}
+
+func IpblocksModelToIPBlockQuery(model ipblocksModel) netdot.IpBlockQuery {
+	return IPBlockModelToIPBlockQuery(ipblockModel{
+		Address:             model.Address,
+		Prefix:              model.Prefix,
+		Version:             model.Version,
+		Status:              model.Status,
+		ASNID:               model.ASNID,
+		Description:         model.Description,
+		Info:                model.Info,
+		InterfaceID:         model.InterfaceID,
+		Monitored:           model.Monitored,
+		OwnerID:             model.OwnerID,
+		ParentID:            model.ParentID,
+		RIR:                 model.RIR,
+		UseNetworkBroadcast: model.UseNetworkBroadcast,
+		UsedByID:            model.UsedByID,
+		VLANID:              model.VLANID,
+	})
+}
+
+// every attribute of the singular ipblock data source, all computed
+func ipblockComputedDataSourceAttributes() map[string]datasourceSchema.Attribute {
+	attributes := make(map[string]datasourceSchema.Attribute, len(ipblockDataSourceSchema.Attributes))
+	for name, attribute := range ipblockDataSourceSchema.Attributes {
+		attributes[name] = attribute
+	}
+
+	delete(attributes, "containing")
+	attributes["id"] = datasourceSchema.Int64Attribute{
+		Description: "ID of the IP block.",
+		Computed:    true,
+	}
+	attributes["address"] = datasourceSchema.StringAttribute{
+		Description: "IP or optionally CIDR of ipblock.",
+		Computed:    true,
+	}
+
+	return attributes
+}
+
+var ipblocksDataSourceSchema = datasourceSchema.Schema{
+	Description: "List of IPs, subnets, and containers in Netdot matching a set of filters.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"address": datasourceSchema.StringAttribute{
+			Description: "Only return blocks with this address.",
+			Optional:    true,
+		},
+		"prefix": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks with this prefix length.",
+			Optional:    true,
+		},
+		"version": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks of this IP version (4 or 6).",
+			Optional:    true,
+		},
+		"status": datasourceSchema.StringAttribute{
+			Description: "Only return blocks with this status. Static | Reserved | Available | Subnet | Container",
+			Optional:    true,
+		},
+		"asn_id": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks associated with this autonomous system ID.",
+			Optional:    true,
+		},
+		"description": datasourceSchema.StringAttribute{
+			Description: "Only return blocks with this description.",
+			Optional:    true,
+		},
+		"info": datasourceSchema.StringAttribute{
+			Description: "Only return blocks with this additional information.",
+			Optional:    true,
+		},
+		"interface_id": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks associated with this interface ID.",
+			Optional:    true,
+		},
+		"monitored": datasourceSchema.BoolAttribute{
+			Description: "Only return blocks that are (or are not) monitored.",
+			Optional:    true,
+		},
+		"owner_id": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks owned by this entity ID.",
+			Optional:    true,
+		},
+		"parent_id": datasourceSchema.Int64Attribute{
+			Description: "Only return direct children of this IP block ID.",
+			Optional:    true,
+		},
+		"rir": datasourceSchema.StringAttribute{
+			Description: "Only return blocks with this RIR.",
+			Optional:    true,
+		},
+		"use_network_broadcast": datasourceSchema.BoolAttribute{
+			Description: "Only return blocks with this use_network_broadcast setting.",
+			Optional:    true,
+		},
+		"used_by_id": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks used by this entity ID.",
+			Optional:    true,
+		},
+		"vlan_id": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks associated with this VLAN ID (within the database, not the actual VLAN ID).",
+			Optional:    true,
+		},
+		"min_prefix": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks with a prefix length greater than or equal to this value.",
+			Optional:    true,
+		},
+		"max_prefix": datasourceSchema.Int64Attribute{
+			Description: "Only return blocks with a prefix length less than or equal to this value.",
+			Optional:    true,
+		},
+		"within": datasourceSchema.StringAttribute{
+			Description: "Only return blocks contained by this CIDR, e.g. 10.1.0.0/16. When it is combined with no filters other than min_prefix and max_prefix, only the part of the IP block tree under it is read.",
+			Optional:    true,
+		},
+		"ipblocks": datasourceSchema.ListNestedAttribute{
+			Description: "IP blocks matching the filters, ordered by address.",
+			Computed:    true,
+			NestedObject: datasourceSchema.NestedAttributeObject{
+				Attributes: ipblockComputedDataSourceAttributes(),
+			},
+		},
+	},
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &ipblocksDataSource{}
+)
+
+// NewIpblocksDataSource is a helper function to simplify the provider implementation.
+func NewIpblocksDataSource() datasource.DataSource {
+	return &ipblocksDataSource{}
+}
+
+// ipblocksDataSource is the data source implementation.
+type ipblocksDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ipblocksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *ipblocksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipblocks"
+}
+
+func (d *ipblocksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ipblocksDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ipblocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ipblocksModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var within netip.Prefix
+	if isPopulated(state.Within) {
+		var err error
+		within, err = netip.ParsePrefix(state.Within.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("within"), "Invalid CIDR", err.Error())
+			return
+		}
+		within = within.Masked()
+	}
+
+	if isPopulated(state.MinPrefix) && isPopulated(state.MaxPrefix) && state.MinPrefix.ValueInt64() > state.MaxPrefix.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("min_prefix"), "Invalid prefix range", "min_prefix must be less than or equal to max_prefix")
+		return
+	}
+
+	// within alone would search every ipblock, so the tree under it is walked instead
+	var ipblocks []models.IpBlock
+	var err error
+	query := IpblocksModelToIPBlockQuery(state)
+	if within.IsValid() && query == (netdot.IpBlockQuery{}) {
+		maxPrefix := -1
+		if isPopulated(state.MaxPrefix) {
+			maxPrefix = int(state.MaxPrefix.ValueInt64())
+		}
+		ipblocks, err = d.client.ListIpBlocksWithin(within, maxPrefix)
+	} else {
+		ipblocks, err = d.client.ListIpBlocks(query)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP blocks", err.Error())
+		return
+	}
+
+	type matchedIpblock struct {
+		prefix netip.Prefix
+		model  ipblockModel
+	}
+
+	var matches []matchedIpblock
+	for _, ipblock := range ipblocks {
+		if isPopulated(state.MinPrefix) && ipblock.Prefix < state.MinPrefix.ValueInt64() {
+			continue
+		}
+
+		if isPopulated(state.MaxPrefix) && ipblock.Prefix > state.MaxPrefix.ValueInt64() {
+			continue
+		}
+
+		prefix, err := netdot.IpBlockPrefix(ipblock)
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing IP block", fmt.Sprintf("IP block %d has an invalid address: %s", ipblock.ID, err))
+			return
+		}
+
+		if within.IsValid() && (prefix.Bits() < within.Bits() || !within.Contains(prefix.Addr())) {
+			continue
+		}
+
+		matches = append(matches, matchedIpblock{prefix: prefix, model: IPBlockToIpblockModel(ipblock)})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if c := matches[i].prefix.Addr().Compare(matches[j].prefix.Addr()); c != 0 {
+			return c < 0
+		}
+		return matches[i].prefix.Bits() < matches[j].prefix.Bits()
+	})
+
+	state.IpBlocks = make([]ipblockModel, 0, len(matches))
+	for _, match := range matches {
+		state.IpBlocks = append(state.IpBlocks, match.model)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
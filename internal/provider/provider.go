@@ -5,6 +5,7 @@ import (
 	"terraform-provider-netdot/internal/netdot"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,7 +15,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &netdotProvider{}
+	_ provider.Provider              = &netdotProvider{}
+	_ provider.ProviderWithFunctions = &netdotProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -122,9 +124,21 @@ func (p *netdotProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *netdotProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewIpblockDataSource,
+		NewIpblocksDataSource,
+		NewAvailableAddressesDataSource,
+		NewSubnetUtilizationDataSource,
+		NewContainingIpblockDataSource,
+		NewIpblockTreeDataSource,
+		NewIpblockStatusesDataSource,
+		NewExpiredReservationsDataSource,
+		NewZoneDataSource,
 		NewRRDataSource,
 		NewRRAddrDataSource,
 		NewRRCnameDataSource,
+		NewRRMxDataSource,
+		NewRRSrvDataSource,
+		NewRRTxtDataSource,
+		NewRRPtrDataSource,
 		NewRRNsDataSource,
 	}
 }
@@ -134,8 +148,30 @@ func (p *netdotProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewRResource,
 		NewIpblockResource,
+		NewIpblockRangeResource,
+		NewReservationReaperResource,
+		NewZoneResource,
+		NewSubnetZoneResource,
 		NewRRAddrResource,
 		NewRRCnameResource,
+		NewRRMxResource,
+		NewRRTxtResource,
+		NewRRSrvResource,
+		NewRRCaaResource,
+		NewRRSshfpResource,
+		NewRRDnskeyResource,
+		NewRRDsResource,
+		NewHostResource,
+		NewRRPtrResource,
 		NewRRNsResource,
 	}
 }
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *netdotProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewEUI64AddressFunction,
+		NewStableAddressFunction,
+		NewDSDigestFunction,
+	}
+}
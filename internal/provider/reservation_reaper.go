@@ -0,0 +1,78 @@
+package provider
+
+import (
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type reservationReaperModel struct {
+	ID       types.String `tfsdk:"id"`
+	ParentID types.Int64  `tfsdk:"parent_id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	At       types.String `tfsdk:"at"`
+	Released types.List   `tfsdk:"released"`
+}
+
+var reservationReaperResourceSchema = resourceSchema.Schema{
+	Description: "Releases the IP blocks whose reserved_until (set through netdot_ipblock) has passed when it is created and whenever parent_id or triggers change. The status of each block is set to Available and description, owner, used_by and the reservation marker are cleared. Plans and refreshes never change netdot, and destroying the resource only removes it from state.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			Description: "The parent_id the reaper looks under, or all when it searches every ipblock.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"parent_id": resourceSchema.Int64Attribute{
+			Description: "Only release direct children of this ipblock. Every ipblock on the server is searched when not set, which can be slow.",
+			Optional:    true,
+		},
+		"triggers": resourceSchema.MapAttribute{
+			Description: "Arbitrary values that release the expired reservations again when they change, e.g. a timestamp rotated by a schedule.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"at": resourceSchema.StringAttribute{
+			Description: "RFC 3339 timestamp of the last release.",
+			Computed:    true,
+		},
+		"released": resourceSchema.ListNestedAttribute{
+			Description: "The reservations released by the last run, ordered by reserved_until.",
+			Computed:    true,
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: map[string]resourceSchema.Attribute{
+					"id": resourceSchema.Int64Attribute{
+						Description: "ID of the IP block.",
+						Computed:    true,
+					},
+					"address": resourceSchema.StringAttribute{
+						Description: "Address of the IP block.",
+						Computed:    true,
+					},
+					"prefix": resourceSchema.Int64Attribute{
+						Description: "The prefix length of the IP block.",
+						Computed:    true,
+					},
+					"status": resourceSchema.StringAttribute{
+						Description: "Status of the IP block before it was released.",
+						Computed:    true,
+					},
+					"description": resourceSchema.StringAttribute{
+						Description: "Description of the IP block before it was released.",
+						Computed:    true,
+					},
+					"parent_id": resourceSchema.Int64Attribute{
+						Description: "ID of the parent IP block.",
+						Computed:    true,
+					},
+					"reserved_until": resourceSchema.StringAttribute{
+						Description: "When the reservation ran out.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
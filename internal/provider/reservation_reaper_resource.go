@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"terraform-provider-netdot/internal/netdot"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &reservationReaperResource{}
+)
+
+func NewReservationReaperResource() resource.Resource {
+	return &reservationReaperResource{}
+}
+
+type reservationReaperResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *reservationReaperResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *reservationReaperResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation_reaper"
+}
+
+func (d *reservationReaperResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = reservationReaperResourceSchema
+}
+
+// Read keeps the result of the last release, looking for expired reservations here would change netdot on refresh.
+func (d *reservationReaperResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reservationReaperModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create releases the expired reservations and sets the initial Terraform state.
+func (r *reservationReaperResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan reservationReaperModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reap(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update releases the expired reservations again after parent_id or triggers changed.
+func (r *reservationReaperResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reservationReaperModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reap(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the reaper from state, released reservations stay released.
+func (r *reservationReaperResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// release every reservation that ran out and record them in the model.
+// A block that can not be released is reported as a warning and left out of released.
+func (r *reservationReaperResource) reap(ctx context.Context, model *reservationReaperModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	at := time.Now().UTC()
+
+	var parentID *int64
+	model.ID = types.StringValue("all")
+	if isPopulated(model.ParentID) {
+		id := model.ParentID.ValueInt64()
+		parentID = &id
+		model.ID = types.StringValue(strconv.FormatInt(id, 10))
+	}
+
+	ipReservationMutex.Lock()
+	defer ipReservationMutex.Unlock()
+
+	expired, err := r.client.ListExpiredReservations(parentID, at)
+	if err != nil {
+		diags.AddError("Error reading expired reservations", err.Error())
+		return diags
+	}
+
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].ReservedUntil.Before(expired[j].ReservedUntil)
+	})
+
+	released := make([]expiredReservationModel, 0, len(expired))
+	for _, reservation := range expired {
+		err := r.client.ReleaseIpBlock(reservation.IpBlock.ID)
+		if err != nil {
+			diags.AddWarning("Error releasing expired reservation", fmt.Sprintf("%s: %s", reservation.IpBlock.Address, err))
+			continue
+		}
+		released = append(released, IpBlockReservationToExpiredReservationModel(reservation))
+	}
+
+	releasedValue, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: expiredReservationAttrTypes}, released)
+	diags.Append(listDiags...)
+
+	model.At = types.StringValue(at.Format(time.RFC3339))
+	model.Released = releasedValue
+
+	return diags
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rrResource{}
+	_ resource.Resource                = &rrResource{}
+	_ resource.ResourceWithImportState = &rrResource{}
 )
 
 func NewRResource() resource.Resource {
@@ -100,23 +103,13 @@ func (r *rrResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
-	var zoneSearchResults struct {
-		RRs []struct {
-			ID int64 `xml:"id,attr"`
-		} `xml:"Zone"`
-	}
-
-	httpCode, err := r.client.Get("/rest/zone?name="+plan.Zone.ValueString(), &zoneSearchResults)
+	zone, err := r.client.GetZoneByName(plan.Zone.ValueString())
 	if err != nil {
-		if httpCode != nil && *httpCode == http.StatusNotFound {
-			resp.Diagnostics.AddError("Zone not found", "No zone found named \""+plan.Zone.ValueString()+"\"")
-			return
-		}
-		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		resp.Diagnostics.AddError("Error reading zone", err.Error())
 		return
 	}
 
-	plan.ZoneID = types.Int64Value(zoneSearchResults.RRs[0].ID)
+	plan.ZoneID = types.Int64Value(zone.ID)
 	createQuery := RRModelToRRQuery(plan)
 
 	var newRR models.RR
@@ -154,23 +147,13 @@ func (r *rrResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
-	var zoneSearchResults struct {
-		RRs []struct {
-			ID int64 `xml:"id,attr"`
-		} `xml:"Zone"`
-	}
-
-	httpCode, err := r.client.Get("/rest/zone?name="+plan.Zone.ValueString(), &zoneSearchResults)
+	zone, err := r.client.GetZoneByName(plan.Zone.ValueString())
 	if err != nil {
-		if httpCode != nil && *httpCode == http.StatusNotFound {
-			resp.Diagnostics.AddError("Zone not found", "No zone found named \""+plan.Zone.ValueString()+"\"")
-			return
-		}
-		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		resp.Diagnostics.AddError("Error reading zone", err.Error())
 		return
 	}
 
-	plan.ZoneID = types.Int64Value(zoneSearchResults.RRs[0].ID)
+	plan.ZoneID = types.Int64Value(zone.ID)
 
 	updateQuery := RRModelToRRQuery(plan)
 
@@ -225,3 +208,20 @@ func (r *rrResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 }
+
+// ImportState accepts the numeric ID of the RR or its FQDN (www.uoregon.edu)
+func (r *rrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	rr, err := r.client.GetRRByFQDN(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rr.ID)...)
+}
@@ -1,24 +1,38 @@
 package provider
 
 import (
+	"net/netip"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type rrAddrModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	IpBlock   types.String `tfsdk:"ipblock"`
-	IpBlockID types.Int64  `tfsdk:"ipblock_id"`
-	RR        types.String `tfsdk:"rr"`
-	RRID      types.Int64  `tfsdk:"rr_id"`
-	TTL       types.Int64  `tfsdk:"ttl"`
+	ID         types.Int64  `tfsdk:"id"`
+	IpBlock    types.String `tfsdk:"ipblock"`
+	IpBlockID  types.Int64  `tfsdk:"ipblock_id"`
+	RecordType types.String `tfsdk:"record_type"`
+	RR         types.String `tfsdk:"rr"`
+	RRID       types.Int64  `tfsdk:"rr_id"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+}
+
+// rrAddrResourceModel is rrAddrModel plus the settings only the resource has
+type rrAddrResourceModel struct {
+	rrAddrModel
+	ManagePTR  types.Bool   `tfsdk:"manage_ptr"`
+	PTRID      types.Int64  `tfsdk:"ptr_id"`
+	PTRDname   types.String `tfsdk:"ptrdname"`
+	PTRAdopted types.Bool   `tfsdk:"ptr_adopted"`
 }
 
 func RRAddrToRRAddrModel(rr models.RRAddr) rrAddrModel {
@@ -27,6 +41,11 @@ func RRAddrToRRAddrModel(rr models.RRAddr) rrAddrModel {
 	finalModel.ID = types.Int64Value(rr.ID)
 	finalModel.IpBlock = types.StringValue(rr.IpBlock)
 	finalModel.IpBlockID = types.Int64Value(rr.IpBlockXlink.ID)
+	finalModel.RecordType = types.StringNull()
+	addr, err := netip.ParseAddr(rr.IpBlock)
+	if err == nil {
+		finalModel.RecordType = types.StringValue(netdot.AddressRecordType(addr))
+	}
 	finalModel.RR = types.StringValue(rr.RR)
 	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
 	finalModel.TTL = autoNullInt64(rr.TTL)
@@ -53,18 +72,22 @@ func RRAddrModelToRRAddrQuery(model rrAddrModel) netdot.RRAddrQuery {
 }
 
 var rrAddrDataSourceSchema = datasourceSchema.Schema{
-	Description: "An A record creates a mapping between an RR and an IP address.",
+	Description: "An A or AAAA record creates a mapping between an RR and an IPv4 or IPv6 address. The record type follows the version of the attached ipblock.",
 	Attributes: map[string]datasourceSchema.Attribute{
 		"id": datasourceSchema.Int64Attribute{
-			Description: "The A record's ID.",
+			Description: "The record's ID.",
 			Optional:    true,
 		},
 		"ipblock": datasourceSchema.StringAttribute{
-			Description: "The CIDR of the A record's associated ipblock.",
+			Description: "The CIDR of the record's associated ipblock.",
 			Optional:    true,
 		},
 		"ipblock_id": datasourceSchema.Int64Attribute{
-			Description: "The ID of the A record's associated ipblock.",
+			Description: "The ID of the record's associated ipblock.",
+			Computed:    true,
+		},
+		"record_type": datasourceSchema.StringAttribute{
+			Description: "A for an IPv4 address, AAAA for an IPv6 address.",
 			Computed:    true,
 		},
 		"rr": datasourceSchema.StringAttribute{
@@ -76,33 +99,41 @@ var rrAddrDataSourceSchema = datasourceSchema.Schema{
 			Computed:    true,
 		},
 		"ttl": datasourceSchema.Int64Attribute{
-			Description: "Time to live for the A record in seconds.",
+			Description: "Time to live for the record in seconds.",
 			Computed:    true,
 		},
 	},
 }
 
 var rrAddrResourceSchema = resourceSchema.Schema{
-	Description: "An A record creates a mapping between an RR and an IP address.",
+	Description: "An A or AAAA record creates a mapping between an RR and an IPv4 or IPv6 address. The record type follows the version of the attached ipblock.",
 	Attributes: map[string]resourceSchema.Attribute{
 		"id": resourceSchema.Int64Attribute{
-			Description: "The A record's ID.",
+			Description: "The record's ID.",
 			Computed:    true,
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
 		"ipblock": resourceSchema.StringAttribute{
-			Description: "The CIDR of the A record's associated ipblock.",
+			Description: "The CIDR of the record's associated ipblock.",
 			Computed:    true,
 		},
 		"ipblock_id": resourceSchema.Int64Attribute{
-			Description: "The ID of the A record's associated ipblock.",
+			Description: "The ID of the record's associated ipblock.",
 			Required:    true,
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
+		"record_type": resourceSchema.StringAttribute{
+			Description: "A for an IPv4 address, AAAA for an IPv6 address. It is derived from the version of the attached ipblock, setting it only checks that the ipblock is of the expected family.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"rr": resourceSchema.StringAttribute{
 			Description: "The associated DNS record.",
 			Computed:    true,
@@ -115,7 +146,7 @@ var rrAddrResourceSchema = resourceSchema.Schema{
 			},
 		},
 		"ttl": resourceSchema.Int64Attribute{
-			Description: "Time to live for the A record in seconds.",
+			Description: "Time to live for the record in seconds.",
 			Optional:    true,
 			Computed:    true,
 			Default:     int64default.StaticInt64(600),
@@ -123,5 +154,32 @@ var rrAddrResourceSchema = resourceSchema.Schema{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
+		"manage_ptr": resourceSchema.BoolAttribute{
+			Description: "Create a PTR record pointing the address back at the RR, and keep it in sync with this record. The PTR is updated when the RR changes and deleted together with the address record.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"ptr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the managed PTR record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ptr_adopted": resourceSchema.BoolAttribute{
+			Description: "Whether the managed PTR record already existed and was taken over instead of created. Such a PTR is kept when the record is destroyed or manage_ptr is turned off.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ptrdname": resourceSchema.StringAttribute{
+			Description: "Name the managed PTR record points back at. When it no longer matches rr, e.g. after the RR was renamed, the next apply updates the PTR.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
 	},
 }
@@ -4,15 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rrAddrResource{}
+	_ resource.Resource                   = &rrAddrResource{}
+	_ resource.ResourceWithImportState    = &rrAddrResource{}
+	_ resource.ResourceWithModifyPlan     = &rrAddrResource{}
+	_ resource.ResourceWithValidateConfig = &rrAddrResource{}
 )
 
 func NewRRAddrResource() resource.Resource {
@@ -55,7 +63,7 @@ func (d *rrAddrResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 
 // Read refreshes the Terraform state with the latest data.
 func (d *rrAddrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state rrAddrModel
+	var state rrAddrResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -79,7 +87,35 @@ func (d *rrAddrResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	newState := RRAddrToRRAddrModel(netdotRR)
+	newState := state
+	newState.rrAddrModel = RRAddrToRRAddrModel(netdotRR)
+
+	// imported records do not manage their PTR until told to
+	if newState.ManagePTR.IsNull() {
+		newState.ManagePTR = types.BoolValue(false)
+	}
+
+	if newState.PTRAdopted.IsNull() {
+		newState.PTRAdopted = types.BoolValue(false)
+	}
+
+	// a PTR deleted outside of terraform is recreated on the next apply, one still pointing at an old name is
+	// caught by ModifyPlan through ptrdname
+	newState.PTRDname = types.StringNull()
+	if isPopulated(newState.PTRID) {
+		var ptr models.RRPtr
+		httpStatusCode, err := d.client.GetResourceByID("rrptr", newState.PTRID.ValueInt64(), &ptr)
+		if err != nil {
+			if httpStatusCode == nil || *httpStatusCode != http.StatusNotFound {
+				resp.Diagnostics.AddError("Error reading PTR", err.Error())
+				return
+			}
+			newState.PTRID = types.Int64Null()
+			newState.PTRAdopted = types.BoolValue(false)
+		} else {
+			newState.PTRDname = types.StringValue(ptr.PTRdname)
+		}
+	}
 
 	// Set state
 	diags := resp.State.Set(ctx, &newState)
@@ -91,7 +127,7 @@ func (d *rrAddrResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *rrAddrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan rrAddrModel
+	var plan rrAddrResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -99,16 +135,36 @@ func (r *rrAddrResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	createQuery := RRAddrModelToRRAddrQuery(plan)
+	// the planned record_type may be left over from a previous ipblock, only a configured one is checked
+	var config rrAddrResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.recordType(plan.IpBlockID.ValueInt64(), config.RecordType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ipblock_id"), "Invalid ipblock", err.Error())
+		return
+	}
+
+	createQuery := RRAddrModelToRRAddrQuery(plan.rrAddrModel)
 
 	var newRRAddr models.RRAddr
-	err := r.client.CreateResource("rraddr", createQuery, &newRRAddr)
+	err = r.client.CreateResource("rraddr", createQuery, &newRRAddr)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating RR", err.Error())
 		return
 	}
 
-	state := RRAddrToRRAddrModel(newRRAddr)
+	state := plan
+	state.rrAddrModel = RRAddrToRRAddrModel(newRRAddr)
+
+	state.PTRID, state.PTRDname, state.PTRAdopted, err = r.syncPTR(newRRAddr, plan.ManagePTR.ValueBool(), types.Int64Null(), types.BoolValue(false))
+	if err != nil {
+		// the address record exists, keep it in state so it is not orphaned
+		resp.Diagnostics.AddError("Error creating PTR", err.Error())
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, state)
@@ -120,7 +176,7 @@ func (r *rrAddrResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *rrAddrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan rrAddrModel
+	var plan rrAddrResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -128,7 +184,7 @@ func (r *rrAddrResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var current_state rrAddrModel
+	var current_state rrAddrResourceModel
 
 	diags = resp.State.Get(ctx, &current_state)
 	resp.Diagnostics.Append(diags...)
@@ -136,7 +192,7 @@ func (r *rrAddrResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updateQuery := RRAddrModelToRRAddrQuery(plan)
+	updateQuery := RRAddrModelToRRAddrQuery(plan.rrAddrModel)
 
 	if current_state.ID.ValueInt64() == 0 {
 		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
@@ -148,14 +204,33 @@ func (r *rrAddrResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// the planned record_type may be left over from a previous ipblock, only a configured one is checked
+	var config rrAddrResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.recordType(plan.IpBlockID.ValueInt64(), config.RecordType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ipblock_id"), "Invalid ipblock", err.Error())
+		return
+	}
+
 	var updatedRRAddr models.RRAddr
-	err := r.client.UpdateResource("rraddr", current_state.ID.ValueInt64(), updateQuery, &updatedRRAddr)
+	err = r.client.UpdateResource("rraddr", current_state.ID.ValueInt64(), updateQuery, &updatedRRAddr)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating RR", err.Error())
 		return
 	}
 
-	state := RRAddrToRRAddrModel(updatedRRAddr)
+	state := plan
+	state.rrAddrModel = RRAddrToRRAddrModel(updatedRRAddr)
+
+	state.PTRID, state.PTRDname, state.PTRAdopted, err = r.syncPTR(updatedRRAddr, plan.ManagePTR.ValueBool(), current_state.PTRID, current_state.PTRAdopted)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating PTR", err.Error())
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, state)
@@ -167,7 +242,7 @@ func (r *rrAddrResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *rrAddrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state rrAddrModel
+	var state rrAddrResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -179,6 +254,18 @@ func (r *rrAddrResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// the PTR goes first, it points at the RR of this record. One that existed before it was taken over is left in place
+	if isPopulated(state.PTRID) && !state.PTRAdopted.ValueBool() {
+		err := r.client.DeleteRRPtr(state.PTRID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting PTR",
+				"Could not delete PTR, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	qBuilder := netdot.NewRRAddrQueryBuilder()
 	qBuilder.SkipDeletingRR(true)
 	qBuilder.NoChangeStatus(true)
@@ -194,3 +281,170 @@ func (r *rrAddrResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// ValidateConfig checks that record_type is one of the address record types.
+func (r *rrAddrResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rrAddrResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isPopulated(config.RecordType) && config.RecordType.ValueString() != "A" && config.RecordType.ValueString() != "AAAA" {
+		resp.Diagnostics.AddAttributeError(path.Root("record_type"), "Invalid record type", fmt.Sprintf("record_type must be A or AAAA, got %q", config.RecordType.ValueString()))
+	}
+}
+
+// ModifyPlan derives record_type from the attached ipblock, and marks ptr_id unknown when the managed PTR
+// is going to be created, replaced or renamed after the RR.
+func (r *rrAddrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan rrAddrResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state rrAddrResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the planned record_type is the prior one when it is not configured, so only the configured one is checked
+	var config rrAddrResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an ipblock created in the same apply is checked by Create and Update instead
+	if isPopulated(plan.IpBlockID) && r.client != nil {
+		recordType, err := r.recordType(plan.IpBlockID.ValueInt64(), config.RecordType)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ipblock_id"), "Invalid ipblock", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_type"), recordType)...)
+	} else if !plan.IpBlockID.Equal(state.IpBlockID) && config.RecordType.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_type"), types.StringUnknown())...)
+	}
+
+	if !plan.ManagePTR.IsUnknown() && !plan.ManagePTR.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptr_id"), types.Int64Null())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptrdname"), types.StringNull())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptr_adopted"), types.BoolValue(false))...)
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// the RR may have been renamed since the PTR was written
+	stalePTR := isPopulated(state.PTRDname) && netdot.NormalizeFQDN(state.PTRDname.ValueString()) != netdot.NormalizeFQDN(state.RR.ValueString())
+
+	if state.PTRID.IsNull() || stalePTR || !plan.IpBlockID.Equal(state.IpBlockID) || !plan.RRID.Equal(state.RRID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptr_id"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptrdname"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ptr_adopted"), types.BoolUnknown())...)
+	}
+}
+
+// ImportState accepts the numeric ID of the record or "fqdn,ip" (www.uoregon.edu,10.1.2.3)
+func (r *rrAddrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID or fqdn,ip, got %q", req.ID))
+		return
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(parts[1]))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing IP address: %s", err))
+		return
+	}
+
+	rraddr, err := r.client.GetRRAddrByFQDN(parts[0], addr)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rraddr.ID)...)
+}
+
+// create, update or remove the PTR record of an A or AAAA record, returns the ID and name of the PTR that is now managed
+// and whether it existed before this record took it over. A PTR that was taken over is never deleted.
+func (r *rrAddrResource) syncPTR(rraddr models.RRAddr, managePTR bool, priorPTRID types.Int64, priorAdopted types.Bool) (types.Int64, types.String, types.Bool, error) {
+	if !managePTR {
+		if isPopulated(priorPTRID) && !priorAdopted.ValueBool() {
+			err := r.client.DeleteRRPtr(priorPTRID.ValueInt64())
+			if err != nil {
+				return priorPTRID, types.StringNull(), priorAdopted, err
+			}
+		}
+		return types.Int64Null(), types.StringNull(), types.BoolValue(false), nil
+	}
+
+	var ptrID *int64
+	if isPopulated(priorPTRID) {
+		id := priorPTRID.ValueInt64()
+		ptrID = &id
+	}
+
+	// SetRRPtr replaces a PTR of another address, a taken over one is let go instead
+	if ptrID != nil && priorAdopted.ValueBool() {
+		var prior models.RRPtr
+		httpStatusCode, err := r.client.GetResourceByID("rrptr", *ptrID, &prior)
+		if err != nil && (httpStatusCode == nil || *httpStatusCode != http.StatusNotFound) {
+			return priorPTRID, types.StringNull(), priorAdopted, err
+		}
+		if err != nil || prior.IpBlockXlink.ID != rraddr.IpBlockXlink.ID {
+			ptrID = nil
+		}
+	}
+
+	ptr, adopted, err := r.client.SetRRPtr(ptrID, rraddr.IpBlockXlink.ID, rraddr.RR, rraddr.TTL)
+	if err != nil {
+		return priorPTRID, types.StringNull(), priorAdopted, err
+	}
+
+	// the prior PTR updated in place keeps its origin
+	if isPopulated(priorPTRID) && priorPTRID.ValueInt64() == ptr.ID {
+		adopted = priorAdopted.ValueBool()
+	}
+
+	return types.Int64Value(ptr.ID), types.StringValue(ptr.PTRdname), types.BoolValue(adopted), nil
+}
+
+// the record type of an address record pointing at the ipblock, checked against the expected type when it is set
+func (r *rrAddrResource) recordType(ipblockID int64, expected types.String) (string, error) {
+	var ipblock models.IpBlock
+	_, err := r.client.GetResourceByID("ipblock", ipblockID, &ipblock)
+	if err != nil {
+		return "", err
+	}
+
+	recordType, err := netdot.AddressRecordTypeForIpBlock(ipblock)
+	if err != nil {
+		return "", err
+	}
+
+	if isPopulated(expected) && expected.ValueString() != recordType {
+		return "", fmt.Errorf("record_type is %s but ipblock %s is an IPv%d address, which needs an %s record", expected.ValueString(), ipblock.Address, ipblock.Version, recordType)
+	}
+
+	return recordType, nil
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrCaaModel struct {
+	ID    types.Int64  `tfsdk:"id"`
+	Flags types.Int64  `tfsdk:"flags"`
+	RR    types.String `tfsdk:"rr"`
+	RRID  types.Int64  `tfsdk:"rr_id"`
+	Tag   types.String `tfsdk:"tag"`
+	TTL   types.Int64  `tfsdk:"ttl"`
+	Value types.String `tfsdk:"value"`
+}
+
+// flags and property tags defined by RFC 8659 and RFC 8657, 128 is the issuer critical flag
+var (
+	rrCaaFlags = map[int64]bool{0: true, 128: true}
+	rrCaaTags  = map[string]bool{"issue": true, "issuewild": true, "iodef": true, "issuemail": true, "contactemail": true, "contactphone": true}
+)
+
+func RRCaaToRRCaaModel(rr models.RRCaa) rrCaaModel {
+	var finalModel rrCaaModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Flags = types.Int64Value(rr.Flags)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.Tag = types.StringValue(rr.Tag)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+	finalModel.Value = types.StringValue(rr.Value)
+
+	return finalModel
+}
+
+func RRCaaModelToRRCaaQuery(model rrCaaModel) netdot.RRCaaQuery {
+	rrCaaQuery := netdot.NewRRCaaQueryBuilder()
+
+	if isPopulated(model.Flags) {
+		rrCaaQuery.Flags(model.Flags.ValueInt64())
+	}
+
+	if isPopulated(model.RRID) {
+		rrCaaQuery.RR(model.RRID.ValueInt64())
+	}
+
+	if isPopulated(model.Tag) {
+		rrCaaQuery.Tag(model.Tag.ValueString())
+	}
+
+	if isPopulated(model.TTL) {
+		rrCaaQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	if isPopulated(model.Value) {
+		rrCaaQuery.Value(model.Value.ValueString())
+	}
+
+	return rrCaaQuery.Build()
+}
+
+var rrCaaResourceSchema = resourceSchema.Schema{
+	Description: "A CAA record restricts which certificate authorities may issue certificates for a resource record (RFC 8659).",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the CAA record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"flags": resourceSchema.Int64Attribute{
+			Description: "Flags of the record, 0 or 128 (issuer critical).",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"tag": resourceSchema.StringAttribute{
+			Description: "Property tag, one of issue, issuewild, iodef, issuemail, contactemail or contactphone.",
+			Required:    true,
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the CAA record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"value": resourceSchema.StringAttribute{
+			Description: "Property value, e.g. the domain of a certificate authority for issue, or a mailto: or https: URL for iodef.",
+			Required:    true,
+		},
+	},
+}
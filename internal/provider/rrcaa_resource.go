@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rrCaaResource{}
+	_ resource.ResourceWithImportState    = &rrCaaResource{}
+	_ resource.ResourceWithValidateConfig = &rrCaaResource{}
+)
+
+func NewRRCaaResource() resource.Resource {
+	return &rrCaaResource{}
+}
+
+type rrCaaResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrCaaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrCaaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caa"
+}
+
+func (d *rrCaaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrCaaResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrCaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrCaaModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRCaa
+
+	httpStatusCode, err := d.client.GetResourceByID("rrcaa", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRCaaToRRCaaModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrCaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrCaaModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createQuery := RRCaaModelToRRCaaQuery(plan)
+
+	var newRRCaa models.RRCaa
+	err := r.client.CreateResource("rrcaa", createQuery, &newRRCaa)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRCaaToRRCaaModel(newRRCaa)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrCaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrCaaModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrCaaModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRCaaModelToRRCaaQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRCaa models.RRCaa
+	err := r.client.UpdateResource("rrcaa", current_state.ID.ValueInt64(), updateQuery, &updatedRRCaa)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRCaaToRRCaaModel(updatedRRCaa)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrCaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrCaaModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	qBuilder := netdot.NewRRCaaQueryBuilder()
+	qBuilder.SkipDeletingRR(true)
+	query := qBuilder.Build()
+
+	// Delete existing order
+	err := r.client.DeleteResourceByID("rrcaa", state.ID.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ValidateConfig checks the flags and tag against the values defined for CAA records.
+func (r *rrCaaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rrCaaModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isPopulated(config.Flags) && !rrCaaFlags[config.Flags.ValueInt64()] {
+		resp.Diagnostics.AddAttributeError(path.Root("flags"), "Invalid flags", fmt.Sprintf("flags must be 0 or 128, got %d", config.Flags.ValueInt64()))
+	}
+
+	if isPopulated(config.Tag) && !rrCaaTags[config.Tag.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("tag"), "Invalid tag", fmt.Sprintf("tag must be one of issue, issuewild, iodef, issuemail, contactemail or contactphone, got %q", config.Tag.ValueString()))
+	}
+
+	if isPopulated(config.Tag) && isPopulated(config.Value) && config.Tag.ValueString() == "iodef" {
+		value := config.Value.ValueString()
+		if !strings.HasPrefix(value, "mailto:") && !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid value", fmt.Sprintf("the value of an iodef property must be a mailto:, http: or https: URL, got %q", value))
+		}
+	}
+}
+
+// ImportState accepts the numeric ID of the record
+func (r *rrCaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+}
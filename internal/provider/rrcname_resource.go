@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rrCnameResource{}
+	_ resource.Resource                = &rrCnameResource{}
+	_ resource.ResourceWithImportState = &rrCnameResource{}
 )
 
 func NewRRCnameResource() resource.Resource {
@@ -193,3 +196,20 @@ func (r *rrCnameResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 }
+
+// ImportState accepts the numeric ID of the record or the FQDN of the alias (www.uoregon.edu)
+func (r *rrCnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	cname, err := r.client.GetRRCnameByFQDN(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cname.ID)...)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DNSSEC algorithms that can still be used to sign a zone (RFC 8624), and the DNSKEY flags of zone signing and key signing keys
+var (
+	dnssecAlgorithms = map[int64]string{5: "RSASHA1", 7: "RSASHA1-NSEC3-SHA1", 8: "RSASHA256", 10: "RSASHA512", 13: "ECDSAP256SHA256", 14: "ECDSAP384SHA384", 15: "ED25519", 16: "ED448"}
+	dnskeyFlags      = map[int64]bool{256: true, 257: true}
+)
+
+func validateDNSSECAlgorithm(algorithm types.Int64, diags *diag.Diagnostics) {
+	if !isPopulated(algorithm) {
+		return
+	}
+
+	if _, ok := dnssecAlgorithms[algorithm.ValueInt64()]; !ok {
+		diags.AddAttributeError(path.Root("algorithm"), "Invalid algorithm", fmt.Sprintf("algorithm must be one of 5, 7, 8, 10, 13, 14, 15 or 16, got %d", algorithm.ValueInt64()))
+	}
+}
+
+type rrDnskeyModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Algorithm types.Int64  `tfsdk:"algorithm"`
+	Flags     types.Int64  `tfsdk:"flags"`
+	KeyTag    types.Int64  `tfsdk:"key_tag"`
+	Protocol  types.Int64  `tfsdk:"protocol"`
+	PublicKey types.String `tfsdk:"public_key"`
+	RR        types.String `tfsdk:"rr"`
+	RRID      types.Int64  `tfsdk:"rr_id"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+}
+
+func RRDnskeyToRRDnskeyModel(rr models.RRDnskey) rrDnskeyModel {
+	var finalModel rrDnskeyModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Algorithm = types.Int64Value(rr.Algorithm)
+	finalModel.Flags = types.Int64Value(rr.Flags)
+	finalModel.Protocol = types.Int64Value(rr.Protocol)
+	finalModel.PublicKey = types.StringValue(rr.PublicKey)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+
+	// netdot does not store the key tag, it is derived from the key itself
+	finalModel.KeyTag = types.Int64Null()
+	keyTag, err := netdot.DNSKEYKeyTag(rr.Flags, rr.Protocol, rr.Algorithm, rr.PublicKey)
+	if err == nil {
+		finalModel.KeyTag = types.Int64Value(keyTag)
+	}
+
+	return finalModel
+}
+
+func RRDnskeyModelToRRDnskeyQuery(model rrDnskeyModel) netdot.RRDnskeyQuery {
+	rrDnskeyQuery := netdot.NewRRDnskeyQueryBuilder()
+
+	if isPopulated(model.Algorithm) {
+		rrDnskeyQuery.Algorithm(model.Algorithm.ValueInt64())
+	}
+
+	if isPopulated(model.Flags) {
+		rrDnskeyQuery.Flags(model.Flags.ValueInt64())
+	}
+
+	if isPopulated(model.Protocol) {
+		rrDnskeyQuery.Protocol(model.Protocol.ValueInt64())
+	}
+
+	if isPopulated(model.PublicKey) {
+		rrDnskeyQuery.PublicKey(model.PublicKey.ValueString())
+	}
+
+	if isPopulated(model.RRID) {
+		rrDnskeyQuery.RR(model.RRID.ValueInt64())
+	}
+
+	if isPopulated(model.TTL) {
+		rrDnskeyQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	return rrDnskeyQuery.Build()
+}
+
+var rrDnskeyResourceSchema = resourceSchema.Schema{
+	Description: "A DNSKEY record publishes a public key used to sign a zone (RFC 4034).",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the DNSKEY record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"algorithm": resourceSchema.Int64Attribute{
+			Description: "Algorithm of the key, e.g. 8 (RSASHA256) or 13 (ECDSAP256SHA256).",
+			Required:    true,
+		},
+		"flags": resourceSchema.Int64Attribute{
+			Description: "Flags of the key, 256 for a zone signing key and 257 for a key signing key.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(257),
+		},
+		"key_tag": resourceSchema.Int64Attribute{
+			Description: "Key tag of the key, as used by the DS records pointing at it.",
+			Computed:    true,
+		},
+		"protocol": resourceSchema.Int64Attribute{
+			Description: "Protocol of the key, always 3.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(3),
+		},
+		"public_key": resourceSchema.StringAttribute{
+			Description: "Public key in base64.",
+			Required:    true,
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name, the name of the zone.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the DNSKEY record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
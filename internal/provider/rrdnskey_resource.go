@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rrDnskeyResource{}
+	_ resource.ResourceWithImportState    = &rrDnskeyResource{}
+	_ resource.ResourceWithValidateConfig = &rrDnskeyResource{}
+)
+
+func NewRRDnskeyResource() resource.Resource {
+	return &rrDnskeyResource{}
+}
+
+type rrDnskeyResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrDnskeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrDnskeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnskey"
+}
+
+func (d *rrDnskeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrDnskeyResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrDnskeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrDnskeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRDnskey
+
+	httpStatusCode, err := d.client.GetResourceByID("rrdnskey", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRDnskeyToRRDnskeyModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrDnskeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrDnskeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createQuery := RRDnskeyModelToRRDnskeyQuery(plan)
+
+	var newRRDnskey models.RRDnskey
+	err := r.client.CreateResource("rrdnskey", createQuery, &newRRDnskey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRDnskeyToRRDnskeyModel(newRRDnskey)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrDnskeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrDnskeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrDnskeyModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRDnskeyModelToRRDnskeyQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRDnskey models.RRDnskey
+	err := r.client.UpdateResource("rrdnskey", current_state.ID.ValueInt64(), updateQuery, &updatedRRDnskey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRDnskeyToRRDnskeyModel(updatedRRDnskey)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrDnskeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrDnskeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	qBuilder := netdot.NewRRDnskeyQueryBuilder()
+	qBuilder.SkipDeletingRR(true)
+	query := qBuilder.Build()
+
+	// Delete existing order
+	err := r.client.DeleteResourceByID("rrdnskey", state.ID.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ValidateConfig checks the algorithm, flags and protocol, and that the public key is base64.
+func (r *rrDnskeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rrDnskeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDNSSECAlgorithm(config.Algorithm, &resp.Diagnostics)
+
+	if isPopulated(config.Flags) && !dnskeyFlags[config.Flags.ValueInt64()] {
+		resp.Diagnostics.AddAttributeError(path.Root("flags"), "Invalid flags", fmt.Sprintf("flags must be 256 (zone signing key) or 257 (key signing key), got %d", config.Flags.ValueInt64()))
+	}
+
+	if isPopulated(config.Protocol) && config.Protocol.ValueInt64() != 3 {
+		resp.Diagnostics.AddAttributeError(path.Root("protocol"), "Invalid protocol", fmt.Sprintf("protocol must be 3, got %d", config.Protocol.ValueInt64()))
+	}
+
+	if isPopulated(config.PublicKey) {
+		// flags, protocol and algorithm are checked above, only the key matters here
+		_, err := netdot.DNSKEYRData(257, 3, 13, config.PublicKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid public key", err.Error())
+		}
+	}
+}
+
+// ImportState accepts the numeric ID of the record
+func (r *rrDnskeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrDsModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	Digest     types.String `tfsdk:"digest"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	RR         types.String `tfsdk:"rr"`
+	RRID       types.Int64  `tfsdk:"rr_id"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+}
+
+func RRDsToRRDsModel(rr models.RRDs) rrDsModel {
+	var finalModel rrDsModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Algorithm = types.Int64Value(rr.Algorithm)
+	finalModel.Digest = types.StringValue(rr.Digest)
+	finalModel.DigestType = types.Int64Value(rr.DigestType)
+	finalModel.KeyTag = types.Int64Value(rr.KeyTag)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+
+	return finalModel
+}
+
+func RRDsModelToRRDsQuery(model rrDsModel) netdot.RRDsQuery {
+	rrDsQuery := netdot.NewRRDsQueryBuilder()
+
+	if isPopulated(model.Algorithm) {
+		rrDsQuery.Algorithm(model.Algorithm.ValueInt64())
+	}
+
+	if isPopulated(model.Digest) {
+		rrDsQuery.Digest(model.Digest.ValueString())
+	}
+
+	if isPopulated(model.DigestType) {
+		rrDsQuery.DigestType(model.DigestType.ValueInt64())
+	}
+
+	if isPopulated(model.KeyTag) {
+		rrDsQuery.KeyTag(model.KeyTag.ValueInt64())
+	}
+
+	if isPopulated(model.RRID) {
+		rrDsQuery.RR(model.RRID.ValueInt64())
+	}
+
+	if isPopulated(model.TTL) {
+		rrDsQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	return rrDsQuery.Build()
+}
+
+var rrDsResourceSchema = resourceSchema.Schema{
+	Description: "A DS record in a parent zone points at the key signing key of a signed child zone (RFC 4034). The digest can be computed from the DNSKEY with the ds_digest function.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the DS record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"algorithm": resourceSchema.Int64Attribute{
+			Description: "Algorithm of the DNSKEY the record points at, e.g. 8 (RSASHA256) or 13 (ECDSAP256SHA256).",
+			Required:    true,
+		},
+		"digest": resourceSchema.StringAttribute{
+			Description: "Digest of the DNSKEY in hex.",
+			Required:    true,
+		},
+		"digest_type": resourceSchema.Int64Attribute{
+			Description: "Hash used for the digest: 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384).",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(2),
+		},
+		"key_tag": resourceSchema.Int64Attribute{
+			Description: "Key tag of the DNSKEY the record points at.",
+			Required:    true,
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name, the name of the delegated zone.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the DS record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rrDsResource{}
+	_ resource.ResourceWithImportState    = &rrDsResource{}
+	_ resource.ResourceWithValidateConfig = &rrDsResource{}
+)
+
+func NewRRDsResource() resource.Resource {
+	return &rrDsResource{}
+}
+
+type rrDsResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrDsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrDsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ds"
+}
+
+func (d *rrDsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrDsResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrDsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrDsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRDs
+
+	httpStatusCode, err := d.client.GetResourceByID("rrds", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRDsToRRDsModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrDsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrDsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createQuery := RRDsModelToRRDsQuery(plan)
+
+	var newRRDs models.RRDs
+	err := r.client.CreateResource("rrds", createQuery, &newRRDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRDsToRRDsModel(newRRDs)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrDsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrDsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrDsModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRDsModelToRRDsQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRDs models.RRDs
+	err := r.client.UpdateResource("rrds", current_state.ID.ValueInt64(), updateQuery, &updatedRRDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRDsToRRDsModel(updatedRRDs)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrDsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrDsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	qBuilder := netdot.NewRRDsQueryBuilder()
+	qBuilder.SkipDeletingRR(true)
+	query := qBuilder.Build()
+
+	// Delete existing order
+	err := r.client.DeleteResourceByID("rrds", state.ID.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ValidateConfig checks the algorithm, key tag and digest type, and that the digest matches its type.
+func (r *rrDsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rrDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDNSSECAlgorithm(config.Algorithm, &resp.Diagnostics)
+
+	if isPopulated(config.KeyTag) && (config.KeyTag.ValueInt64() < 0 || config.KeyTag.ValueInt64() > 65535) {
+		resp.Diagnostics.AddAttributeError(path.Root("key_tag"), "Invalid key tag", fmt.Sprintf("key_tag must be between 0 and 65535, got %d", config.KeyTag.ValueInt64()))
+	}
+
+	// digest_type defaults to SHA-256
+	digestType := int64(2)
+	if config.DigestType.IsUnknown() {
+		return
+	}
+	if !config.DigestType.IsNull() {
+		digestType = config.DigestType.ValueInt64()
+	}
+
+	length, ok := netdot.DSDigestLengths[digestType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("digest_type"), "Invalid digest type", fmt.Sprintf("digest_type must be 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384), got %d", digestType))
+		return
+	}
+
+	if !isPopulated(config.Digest) {
+		return
+	}
+
+	digest := config.Digest.ValueString()
+	if len(digest) != length || strings.Trim(digest, "0123456789abcdefABCDEF") != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("digest"), "Invalid digest", fmt.Sprintf("digest_type %d needs a digest of %d hex characters, got %q", digestType, length, digest))
+	}
+}
+
+// ImportState accepts the numeric ID of the record
+func (r *rrDsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+}
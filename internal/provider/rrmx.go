@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrMxModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Exchange   types.String `tfsdk:"exchange"`
+	Preference types.Int64  `tfsdk:"preference"`
+	RR         types.String `tfsdk:"rr"`
+	RRID       types.Int64  `tfsdk:"rr_id"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+}
+
+func RRMxToRRMxModel(rr models.RRMx) rrMxModel {
+	var finalModel rrMxModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Exchange = types.StringValue(rr.Exchange)
+	finalModel.Preference = types.Int64Value(rr.Preference)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+
+	return finalModel
+}
+
+func RRMxModelToRRMxQuery(model rrMxModel) netdot.RRMxQuery {
+	rrMxQuery := netdot.NewRRMxQueryBuilder()
+
+	if isPopulated(model.TTL) {
+		rrMxQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	if isPopulated(model.Exchange) {
+		rrMxQuery.Exchange(model.Exchange.ValueString())
+	}
+
+	if isPopulated(model.Preference) {
+		rrMxQuery.Preference(model.Preference.ValueInt64())
+	}
+
+	if isPopulated(model.RRID) {
+		rrMxQuery.RR(model.RRID.ValueInt64())
+	}
+
+	return rrMxQuery.Build()
+}
+
+var rrMxDataSourceSchema = datasourceSchema.Schema{
+	Description: "An MX record names a mail exchanger that accepts mail for a resource record.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the MX record.",
+			Optional:    true,
+		},
+		"exchange": datasourceSchema.StringAttribute{
+			Description: "Domain name of the mail exchanger.",
+			Optional:    true,
+		},
+		"preference": datasourceSchema.Int64Attribute{
+			Description: "Preference of the mail exchanger, lower values are tried first.",
+			Computed:    true,
+		},
+		"rr": datasourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Computed:    true,
+		},
+		"ttl": datasourceSchema.Int64Attribute{
+			Description: "Time to live for the MX record in seconds.",
+			Computed:    true,
+		},
+	},
+}
+
+var rrMxResourceSchema = resourceSchema.Schema{
+	Description: "An MX record names a mail exchanger that accepts mail for a resource record.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the MX record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"exchange": resourceSchema.StringAttribute{
+			Description: "Domain name of the mail exchanger. It must not be the name of a CNAME record (RFC 2181, section 10.3).",
+			Required:    true,
+		},
+		"preference": resourceSchema.Int64Attribute{
+			Description: "Preference of the mail exchanger, lower values are tried first.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(10),
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the MX record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rrMxResource{}
+	_ resource.ResourceWithImportState = &rrMxResource{}
+	_ resource.ResourceWithModifyPlan  = &rrMxResource{}
+)
+
+func NewRRMxResource() resource.Resource {
+	return &rrMxResource{}
+}
+
+type rrMxResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrMxResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrMxResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mx"
+}
+
+func (d *rrMxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrMxResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrMxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrMxModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRMx
+
+	httpStatusCode, err := d.client.GetResourceByID("rrmx", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRMxToRRMxModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrMxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrMxModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createQuery := RRMxModelToRRMxQuery(plan)
+
+	var newRRMx models.RRMx
+	err := r.client.CreateResource("rrmx", createQuery, &newRRMx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRMxToRRMxModel(newRRMx)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrMxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrMxModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrMxModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRMxModelToRRMxQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRMx models.RRMx
+	err := r.client.UpdateResource("rrmx", current_state.ID.ValueInt64(), updateQuery, &updatedRRMx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRMxToRRMxModel(updatedRRMx)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrMxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrMxModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	qBuilder := netdot.NewRRMxQueryBuilder()
+	qBuilder.SkipDeletingRR(true)
+	query := qBuilder.Build()
+
+	// Delete existing order
+	err := r.client.DeleteResourceByID("rrmx", state.ID.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ModifyPlan rejects an exchange that is an alias, RFC 2181 section 10.3 forbids MX records pointing at a CNAME.
+// Only a new or changed exchange is checked, and only netdot's own zones can hold such an alias.
+func (r *rrMxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan rrMxModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isPopulated(plan.Exchange) {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state rrMxModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if netdot.NormalizeFQDN(state.Exchange.ValueString()) == netdot.NormalizeFQDN(plan.Exchange.ValueString()) {
+			return
+		}
+	}
+
+	isCNAME, err := r.client.IsCNAME(plan.Exchange.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("exchange"), "Error checking exchange", err.Error())
+		return
+	}
+
+	if isCNAME {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exchange"),
+			"Invalid exchange",
+			fmt.Sprintf("%s is the name of a CNAME record, the exchange of an MX record must not be an alias (RFC 2181, section 10.3).", plan.Exchange.ValueString()),
+		)
+	}
+}
+
+// ImportState accepts the numeric ID of the record or "fqdn,exchange" (uoregon.edu,mx1.uoregon.edu)
+func (r *rrMxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID or fqdn,exchange, got %q", req.ID))
+		return
+	}
+
+	mx, err := r.client.GetRRMxByFQDN(parts[0], strings.TrimSpace(parts[1]))
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), mx.ID)...)
+}
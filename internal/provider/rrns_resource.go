@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"terraform-provider-netdot/internal/netdot"
 	"terraform-provider-netdot/internal/netdot/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &rrNsResource{}
+	_ resource.Resource                = &rrNsResource{}
+	_ resource.ResourceWithImportState = &rrNsResource{}
 )
 
 func NewRRNsResource() resource.Resource {
@@ -192,3 +196,26 @@ func (r *rrNsResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
+
+// ImportState accepts the numeric ID of the record or "fqdn,nsdname" (uoregon.edu,ns1.uoregon.edu)
+func (r *rrNsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID or fqdn,nsdname, got %q", req.ID))
+		return
+	}
+
+	ns, err := r.client.GetRRNsByFQDN(parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ns.ID)...)
+}
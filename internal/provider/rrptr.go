@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrPtrModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	IpBlock   types.String `tfsdk:"ipblock"`
+	IpBlockID types.Int64  `tfsdk:"ipblock_id"`
+	PTRdname  types.String `tfsdk:"ptrdname"`
+	RR        types.String `tfsdk:"rr"`
+	RRID      types.Int64  `tfsdk:"rr_id"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+}
+
+func RRPtrToRRPtrModel(rr models.RRPtr) rrPtrModel {
+	var finalModel rrPtrModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.IpBlock = types.StringValue(rr.IpBlock)
+	finalModel.IpBlockID = types.Int64Value(rr.IpBlockXlink.ID)
+	finalModel.PTRdname = types.StringValue(rr.PTRdname)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+
+	return finalModel
+}
+
+func RRPtrModelToRRPtrQuery(model rrPtrModel) netdot.RRPtrQuery {
+	rrPtrQuery := netdot.NewRRPtrQueryBuilder()
+
+	if isPopulated(model.TTL) {
+		rrPtrQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	if isPopulated(model.IpBlockID) {
+		rrPtrQuery.IpBlock(model.IpBlockID.ValueInt64())
+	}
+
+	if isPopulated(model.PTRdname) {
+		rrPtrQuery.PTRdname(model.PTRdname.ValueString())
+	}
+
+	if isPopulated(model.RRID) {
+		rrPtrQuery.RR(model.RRID.ValueInt64())
+	}
+
+	return rrPtrQuery.Build()
+}
+
+var rrPtrDataSourceSchema = datasourceSchema.Schema{
+	Description: "A PTR record maps an IP address back to a domain name.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the PTR record.",
+			Optional:    true,
+		},
+		"ipblock": datasourceSchema.StringAttribute{
+			Description: "The address the PTR record is for.",
+			Optional:    true,
+		},
+		"ipblock_id": datasourceSchema.Int64Attribute{
+			Description: "The ID of the address's ipblock.",
+			Computed:    true,
+		},
+		"ptrdname": datasourceSchema.StringAttribute{
+			Description: "Domain name the address points back to.",
+			Computed:    true,
+		},
+		"rr": datasourceSchema.StringAttribute{
+			Description: "The in-addr.arpa or ip6.arpa resource record name.",
+			Computed:    true,
+		},
+		"rr_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Computed:    true,
+		},
+		"ttl": datasourceSchema.Int64Attribute{
+			Description: "Time to live for the PTR record in seconds.",
+			Computed:    true,
+		},
+	},
+}
+
+var rrPtrResourceSchema = resourceSchema.Schema{
+	Description: "A PTR record maps an IP address back to a domain name. The in-addr.arpa or ip6.arpa resource record is found or created in the most specific reverse zone netdot has for the address.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the PTR record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipblock": resourceSchema.StringAttribute{
+			Description: "The address the PTR record is for.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipblock_id": resourceSchema.Int64Attribute{
+			Description: "The ID of the address's ipblock.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"ptrdname": resourceSchema.StringAttribute{
+			Description: "Domain name the address points back to.",
+			Required:    true,
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "The in-addr.arpa or ip6.arpa resource record name.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the PTR record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
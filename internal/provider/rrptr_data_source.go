@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &rrPtrDataSource{}
+)
+
+func NewRRPtrDataSource() datasource.DataSource {
+	return &rrPtrDataSource{}
+}
+
+type rrPtrDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrPtrDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrPtrDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ptr"
+}
+
+func (d *rrPtrDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = rrPtrDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrPtrDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state rrPtrModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.IpBlock.IsNull() {
+		resp.Diagnostics.AddError("ID or IpBlock is required", "ID or IpBlock must be provided")
+		return
+	}
+
+	if !state.ID.IsNull() && !state.IpBlock.IsNull() {
+		resp.Diagnostics.AddError("ID and IpBlock are mutually exclusive", "Only one of ID or IpBlock can be provided")
+		return
+	}
+
+	var netdotRRPtr models.RRPtr
+
+	if !state.ID.IsNull() {
+		_, err := d.client.GetResourceByID("rrptr", state.ID.ValueInt64(), &netdotRRPtr)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading RR", err.Error())
+			return
+		}
+	} else {
+		addr, err := netip.ParseAddr(state.IpBlock.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid IpBlock", fmt.Sprintf("ipblock must be an IP address: %s", err))
+			return
+		}
+
+		netdotRRPtr, err = d.client.GetRRPtrByAddress(addr)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading RR", err.Error())
+			return
+		}
+	}
+
+	// keep the address as written, netdot returns its own notation
+	configuredIpBlock := state.IpBlock
+	state = RRPtrToRRPtrModel(netdotRRPtr)
+	if !configuredIpBlock.IsNull() {
+		state.IpBlock = configuredIpBlock
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rrPtrResource{}
+	_ resource.ResourceWithImportState = &rrPtrResource{}
+)
+
+func NewRRPtrResource() resource.Resource {
+	return &rrPtrResource{}
+}
+
+type rrPtrResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrPtrResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrPtrResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ptr"
+}
+
+func (d *rrPtrResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrPtrResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrPtrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrPtrModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRPtr
+
+	httpStatusCode, err := d.client.GetResourceByID("rrptr", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRPtrToRRPtrModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrPtrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrPtrModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ipblock models.IpBlock
+	_, err := r.client.GetResourceByID("ipblock", plan.IpBlockID.ValueInt64(), &ipblock)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block", err.Error())
+		return
+	}
+
+	addr, err := netip.ParseAddr(ipblock.Address)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP block", err.Error())
+		return
+	}
+
+	// the in-addr.arpa or ip6.arpa name of the address
+	ptrRR, err := r.client.EnsurePTRRR(addr)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+	plan.RRID = types.Int64Value(ptrRR.ID)
+
+	createQuery := RRPtrModelToRRPtrQuery(plan)
+
+	var newRRPtr models.RRPtr
+	err = r.client.CreateResource("rrptr", createQuery, &newRRPtr)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRPtrToRRPtrModel(newRRPtr)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrPtrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrPtrModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrPtrModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRPtrModelToRRPtrQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRPtr models.RRPtr
+	err := r.client.UpdateResource("rrptr", current_state.ID.ValueInt64(), updateQuery, &updatedRRPtr)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRPtrToRRPtrModel(updatedRRPtr)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrPtrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrPtrModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	// Delete existing order
+	err := r.client.DeleteRRPtr(state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts the numeric ID of the record or the address it is for (10.1.2.3)
+func (r *rrPtrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID or an IP address, got %q", req.ID))
+		return
+	}
+
+	ptr, err := r.client.GetRRPtrByAddress(addr)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing RR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ptr.ID)...)
+}
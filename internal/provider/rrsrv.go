@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrSrvModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
+	RR       types.String `tfsdk:"rr"`
+	RRID     types.Int64  `tfsdk:"rr_id"`
+	Target   types.String `tfsdk:"target"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Weight   types.Int64  `tfsdk:"weight"`
+}
+
+func RRSrvToRRSrvModel(rr models.RRSrv) rrSrvModel {
+	var finalModel rrSrvModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Port = types.Int64Value(rr.Port)
+	finalModel.Priority = types.Int64Value(rr.Priority)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.Target = types.StringValue(rr.Target)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+	finalModel.Weight = types.Int64Value(rr.Weight)
+
+	return finalModel
+}
+
+func RRSrvModelToRRSrvQuery(model rrSrvModel) netdot.RRSrvQuery {
+	rrSrvQuery := netdot.NewRRSrvQueryBuilder()
+
+	if isPopulated(model.Port) {
+		rrSrvQuery.Port(model.Port.ValueInt64())
+	}
+
+	if isPopulated(model.Priority) {
+		rrSrvQuery.Priority(model.Priority.ValueInt64())
+	}
+
+	if isPopulated(model.RRID) {
+		rrSrvQuery.RR(model.RRID.ValueInt64())
+	}
+
+	if isPopulated(model.Target) {
+		rrSrvQuery.Target(model.Target.ValueString())
+	}
+
+	if isPopulated(model.TTL) {
+		rrSrvQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	if isPopulated(model.Weight) {
+		rrSrvQuery.Weight(model.Weight.ValueInt64())
+	}
+
+	return rrSrvQuery.Build()
+}
+
+var rrSrvDataSourceSchema = datasourceSchema.Schema{
+	Description: "An SRV record locates the hosts offering a service, such as Kerberos, LDAP or SIP (RFC 2782). Look it up by id, or by rr_id when the resource record has a single SRV record, adding target otherwise.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the SRV record.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"port": datasourceSchema.Int64Attribute{
+			Description: "Port the service listens on.",
+			Computed:    true,
+		},
+		"priority": datasourceSchema.Int64Attribute{
+			Description: "Priority of the target host, lower values are tried first.",
+			Computed:    true,
+		},
+		"rr": datasourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"target": datasourceSchema.StringAttribute{
+			Description: "Hostname of the host offering the service.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"ttl": datasourceSchema.Int64Attribute{
+			Description: "Time to live for the SRV record in seconds.",
+			Computed:    true,
+		},
+		"weight": datasourceSchema.Int64Attribute{
+			Description: "Relative weight of targets with the same priority.",
+			Computed:    true,
+		},
+	},
+}
+
+var rrSrvResourceSchema = resourceSchema.Schema{
+	Description: "An SRV record locates the hosts offering a service, such as Kerberos, LDAP or SIP (RFC 2782). The name of the associated resource record must start with _service._proto, e.g. _ldap._tcp.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the SRV record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"port": resourceSchema.Int64Attribute{
+			Description: "Port the service listens on, between 0 and 65535.",
+			Required:    true,
+		},
+		"priority": resourceSchema.Int64Attribute{
+			Description: "Priority of the target host, lower values are tried first. Between 0 and 65535.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record, its name must start with _service._proto.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"target": resourceSchema.StringAttribute{
+			Description: "Hostname of the host offering the service. A single dot means the service is not available at this domain.",
+			Required:    true,
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the SRV record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"weight": resourceSchema.Int64Attribute{
+			Description: "Relative weight of targets with the same priority, between 0 and 65535.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+	},
+}
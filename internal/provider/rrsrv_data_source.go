@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &rrSrvDataSource{}
+)
+
+func NewRRSrvDataSource() datasource.DataSource {
+	return &rrSrvDataSource{}
+}
+
+type rrSrvDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrSrvDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrSrvDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_srv"
+}
+
+func (d *rrSrvDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = rrSrvDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrSrvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state rrSrvModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var netdotRRSrv models.RRSrv
+
+	switch {
+	case isPopulated(state.ID):
+		_, err := d.client.GetResourceByID("rrsrv", state.ID.ValueInt64(), &netdotRRSrv)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading RR", err.Error())
+			return
+		}
+	case isPopulated(state.RRID):
+		queryBuilder := netdot.NewRRSrvQueryBuilder()
+		queryBuilder.RR(state.RRID.ValueInt64())
+		if isPopulated(state.Target) {
+			queryBuilder.Target(state.Target.ValueString())
+		}
+
+		srvs, err := d.client.ListRRSrvs(queryBuilder.Build())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading RR", err.Error())
+			return
+		}
+
+		matches := []models.RRSrv{}
+		for _, srv := range srvs {
+			if !isPopulated(state.Target) || netdot.NormalizeFQDN(srv.Target) == netdot.NormalizeFQDN(state.Target.ValueString()) {
+				matches = append(matches, srv)
+			}
+		}
+
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Error reading RR", fmt.Sprintf("%d SRV records found for RR %d, expected exactly one", len(matches), state.RRID.ValueInt64()))
+			return
+		}
+		netdotRRSrv = matches[0]
+	default:
+		resp.Diagnostics.AddError("ID is required", "Either id or rr_id must be provided")
+		return
+	}
+
+	state = RRSrvToRRSrvModel(netdotRRSrv)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
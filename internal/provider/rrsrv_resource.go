@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rrSrvResource{}
+	_ resource.ResourceWithImportState    = &rrSrvResource{}
+	_ resource.ResourceWithModifyPlan     = &rrSrvResource{}
+	_ resource.ResourceWithValidateConfig = &rrSrvResource{}
+)
+
+func NewRRSrvResource() resource.Resource {
+	return &rrSrvResource{}
+}
+
+type rrSrvResource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rrSrvResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *rrSrvResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_srv"
+}
+
+func (d *rrSrvResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rrSrvResourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rrSrvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rrSrvModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is required", "ID must be provided")
+		return
+	}
+
+	var netdotRR models.RRSrv
+
+	httpStatusCode, err := d.client.GetResourceByID("rrsrv", state.ID.ValueInt64(), &netdotRR)
+	if err != nil {
+		if httpStatusCode != nil && *httpStatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading RR", err.Error())
+		return
+	}
+
+	newState := RRSrvToRRSrvModel(netdotRR)
+
+	// Set state
+	diags := resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rrSrvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rrSrvModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rr_id is unknown at plan time when the resource record is created in the same apply
+	err := r.validateOwner(plan.RRID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rr_id"), "Invalid resource record", err.Error())
+		return
+	}
+
+	createQuery := RRSrvModelToRRSrvQuery(plan)
+
+	var newRRSrv models.RRSrv
+	err = r.client.CreateResource("rrsrv", createQuery, &newRRSrv)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating RR", err.Error())
+		return
+	}
+
+	state := RRSrvToRRSrvModel(newRRSrv)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rrSrvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rrSrvModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current_state rrSrvModel
+
+	diags = resp.State.Get(ctx, &current_state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateQuery := RRSrvModelToRRSrvQuery(plan)
+
+	if current_state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	if current_state.ID.ValueInt64() != plan.ID.ValueInt64() {
+		resp.Diagnostics.AddError("ID mismatch", "ID in plan does not match ID in state")
+		return
+	}
+
+	var updatedRRSrv models.RRSrv
+	err := r.client.UpdateResource("rrsrv", current_state.ID.ValueInt64(), updateQuery, &updatedRRSrv)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating RR", err.Error())
+		return
+	}
+
+	state := RRSrvToRRSrvModel(updatedRRSrv)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rrSrvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rrSrvModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueInt64() == 0 {
+		resp.Diagnostics.AddError("Invalid ID", "ID must be greater than 0")
+		return
+	}
+
+	qBuilder := netdot.NewRRSrvQueryBuilder()
+	qBuilder.SkipDeletingRR(true)
+	query := qBuilder.Build()
+
+	// Delete existing order
+	err := r.client.DeleteResourceByID("rrsrv", state.ID.ValueInt64(), query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting RR",
+			"Could not delete RR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ValidateConfig checks the target and the 16 bit fields of the record.
+func (r *rrSrvResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rrSrvModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// "." is how RFC 2782 says the service is decidedly not available
+	if isPopulated(config.Target) && config.Target.ValueString() != "." {
+		err := netdot.ValidateHostname(config.Target.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("target"), "Invalid target", err.Error())
+		}
+	}
+
+	for name, value := range map[string]types.Int64{"port": config.Port, "priority": config.Priority, "weight": config.Weight} {
+		if isPopulated(value) && (value.ValueInt64() < 0 || value.ValueInt64() > 65535) {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid "+name, fmt.Sprintf("%s must be between 0 and 65535, got %d", name, value.ValueInt64()))
+		}
+	}
+}
+
+// ModifyPlan checks the name of the associated resource record when it is already known.
+// Records created in the same apply are checked by Create instead.
+func (r *rrSrvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan rrSrvModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isPopulated(plan.RRID) {
+		return
+	}
+
+	err := r.validateOwner(plan.RRID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rr_id"), "Invalid resource record", err.Error())
+	}
+}
+
+// ImportState accepts the numeric ID of the record
+func (r *rrSrvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	myID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected a numeric ID, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), myID)...)
+}
+
+// check that the resource record an SRV record is attached to is named _service._proto
+func (r *rrSrvResource) validateOwner(rrID int64) error {
+	var rr models.RR
+	_, err := r.client.GetResourceByID("rr", rrID, &rr)
+	if err != nil {
+		return err
+	}
+
+	return netdot.ValidateSRVOwnerName(rr.Name)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrSshfpModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Algorithm   types.Int64  `tfsdk:"algorithm"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	FPType      types.Int64  `tfsdk:"fingerprint_type"`
+	RR          types.String `tfsdk:"rr"`
+	RRID        types.Int64  `tfsdk:"rr_id"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+}
+
+// key algorithms (RFC 4255, 6594, 7479 and 8709) and the hex length of each fingerprint type
+var (
+	rrSshfpAlgorithms = map[int64]string{1: "RSA", 2: "DSA", 3: "ECDSA", 4: "Ed25519", 6: "Ed448"}
+	rrSshfpFPLengths  = map[int64]int{1: 40, 2: 64}
+)
+
+func RRSshfpToRRSshfpModel(rr models.RRSshfp) rrSshfpModel {
+	var finalModel rrSshfpModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.Algorithm = types.Int64Value(rr.Algorithm)
+	finalModel.Fingerprint = types.StringValue(rr.Fingerprint)
+	finalModel.FPType = types.Int64Value(rr.FPType)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+
+	return finalModel
+}
+
+func RRSshfpModelToRRSshfpQuery(model rrSshfpModel) netdot.RRSshfpQuery {
+	rrSshfpQuery := netdot.NewRRSshfpQueryBuilder()
+
+	if isPopulated(model.Algorithm) {
+		rrSshfpQuery.Algorithm(model.Algorithm.ValueInt64())
+	}
+
+	if isPopulated(model.Fingerprint) {
+		rrSshfpQuery.Fingerprint(model.Fingerprint.ValueString())
+	}
+
+	if isPopulated(model.FPType) {
+		rrSshfpQuery.FPType(model.FPType.ValueInt64())
+	}
+
+	if isPopulated(model.RRID) {
+		rrSshfpQuery.RR(model.RRID.ValueInt64())
+	}
+
+	if isPopulated(model.TTL) {
+		rrSshfpQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	return rrSshfpQuery.Build()
+}
+
+var rrSshfpResourceSchema = resourceSchema.Schema{
+	Description: "An SSHFP record publishes the fingerprint of an SSH host key so clients can verify it through DNS (RFC 4255). The values are the ones printed by ssh-keygen -r.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the SSHFP record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"algorithm": resourceSchema.Int64Attribute{
+			Description: "Algorithm of the host key: 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448).",
+			Required:    true,
+		},
+		"fingerprint": resourceSchema.StringAttribute{
+			Description: "Fingerprint of the host key in lowercase hex, 40 characters for SHA-1 and 64 for SHA-256.",
+			Required:    true,
+		},
+		"fingerprint_type": resourceSchema.Int64Attribute{
+			Description: "Hash used for the fingerprint: 1 (SHA-1) or 2 (SHA-256).",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(2),
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the SSHFP record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rrTxtModel struct {
+	ID    types.Int64  `tfsdk:"id"`
+	RR    types.String `tfsdk:"rr"`
+	RRID  types.Int64  `tfsdk:"rr_id"`
+	TTL   types.Int64  `tfsdk:"ttl"`
+	Value types.String `tfsdk:"value"`
+}
+
+func RRTxtToRRTxtModel(rr models.RRTxt) rrTxtModel {
+	var finalModel rrTxtModel
+
+	finalModel.ID = types.Int64Value(rr.ID)
+	finalModel.RR = types.StringValue(rr.RR)
+	finalModel.RRID = types.Int64Value(rr.RRXlink.ID)
+	finalModel.TTL = autoNullInt64(rr.TTL)
+	// netdot stores the character-strings, terraform the value they make up
+	finalModel.Value = types.StringValue(netdot.JoinTXT(rr.TxtData))
+
+	return finalModel
+}
+
+func RRTxtModelToRRTxtQuery(model rrTxtModel) netdot.RRTxtQuery {
+	rrTxtQuery := netdot.NewRRTxtQueryBuilder()
+
+	if isPopulated(model.TTL) {
+		rrTxtQuery.TTL(model.TTL.ValueInt64())
+	}
+
+	if isPopulated(model.Value) {
+		rrTxtQuery.Value(model.Value.ValueString())
+	}
+
+	if isPopulated(model.RRID) {
+		rrTxtQuery.RR(model.RRID.ValueInt64())
+	}
+
+	return rrTxtQuery.Build()
+}
+
+var rrTxtDataSourceSchema = datasourceSchema.Schema{
+	Description: "A TXT record holds free form text for a resource record, such as SPF policies, DKIM keys and domain verification tokens.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the TXT record.",
+			Required:    true,
+		},
+		"rr": datasourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": datasourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Computed:    true,
+		},
+		"ttl": datasourceSchema.Int64Attribute{
+			Description: "Time to live for the TXT record in seconds.",
+			Computed:    true,
+		},
+		"value": datasourceSchema.StringAttribute{
+			Description: "Text of the record, with its character-strings joined back together.",
+			Computed:    true,
+		},
+	},
+}
+
+var rrTxtResourceSchema = resourceSchema.Schema{
+	Description: "A TXT record holds free form text for a resource record, such as SPF policies, DKIM keys and domain verification tokens.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the TXT record.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"rr": resourceSchema.StringAttribute{
+			Description: "Associated resource record name.",
+			Computed:    true,
+		},
+		"rr_id": resourceSchema.Int64Attribute{
+			Description: "ID of the associated resource record.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ttl": resourceSchema.Int64Attribute{
+			Description: "Time to live for the TXT record in seconds.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(600),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"value": resourceSchema.StringAttribute{
+			Description: "Text of the record, unquoted. Values longer than 255 bytes are split into several character-strings (RFC 1035, section 3.3) and joined back together on read.",
+			Required:    true,
+		},
+	},
+}
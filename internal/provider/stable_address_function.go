@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-netdot/internal/netdot"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &stableAddressFunction{}
+)
+
+func NewStableAddressFunction() function.Function {
+	return &stableAddressFunction{}
+}
+
+type stableAddressFunction struct{}
+
+func (f *stableAddressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "stable_address"
+}
+
+func (f *stableAddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Stable address derived from a name inside an IPv6 prefix.",
+		Description: "Fills the host bits of the prefix with a SHA-256 hash of the name, so the same name (case-insensitive, trailing dot ignored) always gets the same address. The network address, the gateway and the RFC 2526 anycast addresses are never returned. The address is not checked against netdot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "IPv6 prefix of /120 or shorter in CIDR notation, e.g. 2001:db8:1:2::/64.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name to derive the address from, usually the hostname.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *stableAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefixString, name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefixString, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, isCIDR, err := netdot.ParseAddressOrPrefix(prefixString)
+	if err != nil || !isCIDR {
+		resp.Error = function.NewArgumentFuncError(0, "prefix must be an IPv6 prefix in CIDR notation")
+		return
+	}
+
+	addr, err := netdot.StableHashAddress(prefix, name)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, addr.String()))
+}
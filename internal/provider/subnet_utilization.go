@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type subnetUtilizationModel struct {
+	ID                    types.Int64            `tfsdk:"id"`
+	Subnet                types.String           `tfsdk:"subnet"`
+	Total                 types.Int64            `tfsdk:"total"`
+	Used                  types.Int64            `tfsdk:"used"`
+	Free                  types.Int64            `tfsdk:"free"`
+	Unallocated           types.Int64            `tfsdk:"unallocated"`
+	PercentUsed           types.Float64          `tfsdk:"percent_used"`
+	StatusCounts          map[string]types.Int64 `tfsdk:"status_counts"`
+	LargestFreeRangeStart types.String           `tfsdk:"largest_free_range_start"`
+	LargestFreeRangeEnd   types.String           `tfsdk:"largest_free_range_end"`
+	LargestFreeRangeSize  types.Int64            `tfsdk:"largest_free_range_size"`
+}
+
+func IpBlockUtilizationToSubnetUtilizationModel(model *subnetUtilizationModel, utilization netdot.IpBlockUtilization) {
+	model.Total = types.Int64Value(utilization.Total)
+	model.Used = types.Int64Value(utilization.Used)
+	model.Free = types.Int64Value(utilization.Free)
+	model.Unallocated = types.Int64Value(utilization.Unallocated)
+	model.PercentUsed = types.Float64Value(utilization.PercentUsed)
+
+	model.StatusCounts = make(map[string]types.Int64, len(utilization.StatusCounts))
+	for status, count := range utilization.StatusCounts {
+		model.StatusCounts[status] = types.Int64Value(count)
+	}
+
+	model.LargestFreeRangeStart = types.StringNull()
+	model.LargestFreeRangeEnd = types.StringNull()
+	if utilization.LargestFreeRangeStart.IsValid() {
+		model.LargestFreeRangeStart = types.StringValue(utilization.LargestFreeRangeStart.String())
+		model.LargestFreeRangeEnd = types.StringValue(utilization.LargestFreeRangeEnd.String())
+	}
+	model.LargestFreeRangeSize = types.Int64Value(utilization.LargestFreeRangeSize)
+}
+
+var subnetUtilizationDataSourceSchema = datasourceSchema.Schema{
+	Description: "Address usage of a subnet or container, for capacity planning and check blocks.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the IP block.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"subnet": datasourceSchema.StringAttribute{
+			Description: "CIDR of the IP block.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"total": datasourceSchema.Int64Attribute{
+			Description: "Number of usable addresses in the block. Subnets count the same addresses netdot_available_addresses allocates from, so the network address, the gateway, the IPv4 broadcast address and the RFC 2526 anycast addresses of IPv6 subnets are left out.",
+			Computed:    true,
+		},
+		"used": datasourceSchema.Int64Attribute{
+			Description: "Number of addresses covered by a child block with any status other than Available.",
+			Computed:    true,
+		},
+		"free": datasourceSchema.Int64Attribute{
+			Description: "Number of addresses that are Available or have no IP block.",
+			Computed:    true,
+		},
+		"unallocated": datasourceSchema.Int64Attribute{
+			Description: "Number of addresses that have no IP block. The rest of free is counted under Available in status_counts.",
+			Computed:    true,
+		},
+		"percent_used": datasourceSchema.Float64Attribute{
+			Description: "Percentage of usable addresses in use, from 0 to 100.",
+			Computed:    true,
+		},
+		"status_counts": datasourceSchema.MapAttribute{
+			Description: "Number of addresses covered by child blocks, per status. Static, Dhcp, Reserved and Available are always present, other statuses are included when used.",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"largest_free_range_start": datasourceSchema.StringAttribute{
+			Description: "First address of the largest contiguous free range, null when the block is full.",
+			Computed:    true,
+		},
+		"largest_free_range_end": datasourceSchema.StringAttribute{
+			Description: "Last address of the largest contiguous free range, null when the block is full.",
+			Computed:    true,
+		},
+		"largest_free_range_size": datasourceSchema.Int64Attribute{
+			Description: "Number of addresses in the largest contiguous free range.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &subnetUtilizationDataSource{}
+)
+
+func NewSubnetUtilizationDataSource() datasource.DataSource {
+	return &subnetUtilizationDataSource{}
+}
+
+type subnetUtilizationDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *subnetUtilizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *subnetUtilizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet_utilization"
+}
+
+func (d *subnetUtilizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = subnetUtilizationDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *subnetUtilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state subnetUtilizationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Subnet.IsNull() {
+		resp.Diagnostics.AddError("ID or Subnet is required", "ID or Subnet must be provided")
+		return
+	}
+
+	if !state.ID.IsNull() && !state.Subnet.IsNull() {
+		resp.Diagnostics.AddError("ID and Subnet are mutually exclusive", "Only one of ID or Subnet can be provided")
+		return
+	}
+
+	var subnet models.IpBlock
+	if state.ID.IsNull() {
+		prefix, err := netip.ParsePrefix(state.Subnet.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet"), "Invalid CIDR", err.Error())
+			return
+		}
+
+		subnet, err = d.client.GetIpBlockByPrefix(prefix.Masked())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subnet", err.Error())
+			return
+		}
+		state.ID = types.Int64Value(subnet.ID)
+	} else {
+		_, err := d.client.GetResourceByID("ipblock", state.ID.ValueInt64(), &subnet)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading subnet", err.Error())
+			return
+		}
+		state.Subnet = types.StringValue(fmt.Sprintf("%s/%d", subnet.Address, subnet.Prefix))
+	}
+
+	utilization, err := d.client.GetIpBlockUtilization(subnet)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading subnet utilization", err.Error())
+		return
+	}
+
+	IpBlockUtilizationToSubnetUtilizationModel(&state, utilization)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type subnetZoneModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	SubnetID types.Int64  `tfsdk:"subnet_id"`
+	Subnet   types.String `tfsdk:"subnet"`
+	ZoneID   types.Int64  `tfsdk:"zone_id"`
+	Zone     types.String `tfsdk:"zone"`
+}
+
+func SubnetZoneToSubnetZoneModel(subnetZone models.SubnetZone) subnetZoneModel {
+	var finalModel subnetZoneModel
+
+	finalModel.ID = types.Int64Value(subnetZone.ID)
+	finalModel.SubnetID = types.Int64Value(subnetZone.SubnetXlink.ID)
+	finalModel.Subnet = types.StringValue(subnetZone.Subnet)
+	finalModel.ZoneID = types.Int64Value(subnetZone.ZoneXlink.ID)
+	finalModel.Zone = types.StringValue(subnetZone.Zone)
+
+	return finalModel
+}
+
+func SubnetZoneModelToSubnetZoneQuery(model subnetZoneModel) netdot.SubnetZoneQuery {
+	subnetZoneQuery := netdot.NewSubnetZoneQueryBuilder()
+
+	if isPopulated(model.SubnetID) {
+		subnetZoneQuery.Subnet(model.SubnetID.ValueInt64())
+	}
+
+	if isPopulated(model.ZoneID) {
+		subnetZoneQuery.Zone(model.ZoneID.ValueInt64())
+	}
+
+	return subnetZoneQuery.Build()
+}
+
+var subnetZoneResourceSchema = resourceSchema.Schema{
+	Description: "Links a subnet to the (usually reverse) DNS zone its PTR records are created in.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the subnet zone link.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"subnet_id": resourceSchema.Int64Attribute{
+			Description: "ID of the Subnet ipblock.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"subnet": resourceSchema.StringAttribute{
+			Description: "CIDR of the subnet.",
+			Computed:    true,
+		},
+		"zone_id": resourceSchema.Int64Attribute{
+			Description: "ID of the zone.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"zone": resourceSchema.StringAttribute{
+			Description: "Name of the zone.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type zoneModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Active     types.Bool   `tfsdk:"active"`
+	DefaultTTL types.Int64  `tfsdk:"default_ttl"`
+	Expire     types.Int64  `tfsdk:"expire"`
+	ExportFile types.String `tfsdk:"export_file"`
+	Info       types.String `tfsdk:"info"`
+	Minimum    types.Int64  `tfsdk:"minimum"`
+	MName      types.String `tfsdk:"mname"`
+	Refresh    types.Int64  `tfsdk:"refresh"`
+	Retry      types.Int64  `tfsdk:"retry"`
+	RName      types.String `tfsdk:"rname"`
+	Serial     types.Int64  `tfsdk:"serial"`
+}
+
+func ZoneToZoneModel(zone models.Zone) zoneModel {
+	var finalModel zoneModel
+
+	finalModel.ID = types.Int64Value(zone.ID)
+	finalModel.Name = types.StringValue(zone.Name)
+	finalModel.Active = types.BoolValue(zone.Active)
+	finalModel.DefaultTTL = types.Int64Value(zone.DefaultTTL)
+	finalModel.Expire = types.Int64Value(zone.Expire)
+	finalModel.ExportFile = types.StringValue(zone.ExportFile)
+	finalModel.Info = autoNullString(zone.Info)
+	finalModel.Minimum = types.Int64Value(zone.Minimum)
+	finalModel.MName = types.StringValue(zone.MName)
+	finalModel.Refresh = types.Int64Value(zone.Refresh)
+	finalModel.Retry = types.Int64Value(zone.Retry)
+	finalModel.RName = types.StringValue(zone.RName)
+	finalModel.Serial = types.Int64Value(zone.Serial)
+
+	return finalModel
+}
+
+func ZoneModelToZoneQuery(model zoneModel) netdot.ZoneQuery {
+	zoneQuery := netdot.NewZoneQueryBuilder()
+
+	if isPopulated(model.Name) {
+		zoneQuery.Name(model.Name.ValueString())
+	}
+
+	if isPopulated(model.Active) {
+		zoneQuery.Active(model.Active.ValueBool())
+	}
+
+	if isPopulated(model.DefaultTTL) {
+		zoneQuery.DefaultTTL(model.DefaultTTL.ValueInt64())
+	}
+
+	if isPopulated(model.Expire) {
+		zoneQuery.Expire(model.Expire.ValueInt64())
+	}
+
+	if isPopulated(model.ExportFile) {
+		zoneQuery.ExportFile(model.ExportFile.ValueString())
+	}
+
+	if isPopulated(model.Info) {
+		zoneQuery.Info(model.Info.ValueString())
+	}
+
+	if isPopulated(model.Minimum) {
+		zoneQuery.Minimum(model.Minimum.ValueInt64())
+	}
+
+	if isPopulated(model.MName) {
+		zoneQuery.MName(model.MName.ValueString())
+	}
+
+	if isPopulated(model.Refresh) {
+		zoneQuery.Refresh(model.Refresh.ValueInt64())
+	}
+
+	if isPopulated(model.Retry) {
+		zoneQuery.Retry(model.Retry.ValueInt64())
+	}
+
+	if isPopulated(model.RName) {
+		zoneQuery.RName(model.RName.ValueString())
+	}
+
+	if isPopulated(model.Serial) {
+		zoneQuery.Serial(model.Serial.ValueInt64())
+	}
+
+	return zoneQuery.Build()
+}
+
+var zoneDataSourceSchema = datasourceSchema.Schema{
+	Description: "A DNS zone and its SOA settings.",
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.Int64Attribute{
+			Description: "ID of the zone.",
+			Optional:    true,
+		},
+		"name": datasourceSchema.StringAttribute{
+			Description: "Name of the zone, e.g. uoregon.edu or 15.171.184.in-addr.arpa.",
+			Optional:    true,
+		},
+		"active": datasourceSchema.BoolAttribute{
+			Description: "Whether the zone is exported.",
+			Computed:    true,
+		},
+		"default_ttl": datasourceSchema.Int64Attribute{
+			Description: "Default TTL of records in the zone in seconds.",
+			Computed:    true,
+		},
+		"expire": datasourceSchema.Int64Attribute{
+			Description: "SOA expire in seconds.",
+			Computed:    true,
+		},
+		"export_file": datasourceSchema.StringAttribute{
+			Description: "File the zone is exported to.",
+			Computed:    true,
+		},
+		"info": datasourceSchema.StringAttribute{
+			Description: "Additional information about the zone.",
+			Computed:    true,
+		},
+		"minimum": datasourceSchema.Int64Attribute{
+			Description: "SOA minimum (negative caching TTL) in seconds.",
+			Computed:    true,
+		},
+		"mname": datasourceSchema.StringAttribute{
+			Description: "SOA mname, the primary name server of the zone.",
+			Computed:    true,
+		},
+		"refresh": datasourceSchema.Int64Attribute{
+			Description: "SOA refresh in seconds.",
+			Computed:    true,
+		},
+		"retry": datasourceSchema.Int64Attribute{
+			Description: "SOA retry in seconds.",
+			Computed:    true,
+		},
+		"rname": datasourceSchema.StringAttribute{
+			Description: "SOA rname, the mailbox of the person responsible for the zone.",
+			Computed:    true,
+		},
+		"serial": datasourceSchema.Int64Attribute{
+			Description: "SOA serial.",
+			Computed:    true,
+		},
+	},
+}
+
+var zoneResourceSchema = resourceSchema.Schema{
+	Description: "A DNS zone and its SOA settings. SOA settings that are not set take netdot's configured defaults.",
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.Int64Attribute{
+			Description: "ID of the zone.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": resourceSchema.StringAttribute{
+			Description: "Name of the zone, e.g. uoregon.edu or 15.171.184.in-addr.arpa.",
+			Required:    true,
+		},
+		"active": resourceSchema.BoolAttribute{
+			Description: "Whether the zone is exported.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"default_ttl": resourceSchema.Int64Attribute{
+			Description: "Default TTL of records in the zone in seconds.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"expire": resourceSchema.Int64Attribute{
+			Description: "SOA expire in seconds.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"export_file": resourceSchema.StringAttribute{
+			Description: "File the zone is exported to.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"info": resourceSchema.StringAttribute{
+			Description: "Additional information about the zone.",
+			Optional:    true,
+		},
+		"minimum": resourceSchema.Int64Attribute{
+			Description: "SOA minimum (negative caching TTL) in seconds.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"mname": resourceSchema.StringAttribute{
+			Description: "SOA mname, the primary name server of the zone.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"refresh": resourceSchema.Int64Attribute{
+			Description: "SOA refresh in seconds.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"retry": resourceSchema.Int64Attribute{
+			Description: "SOA retry in seconds.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"rname": resourceSchema.StringAttribute{
+			Description: "SOA rname, the mailbox of the person responsible for the zone.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"serial": resourceSchema.Int64Attribute{
+			Description: "SOA serial. Netdot bumps it whenever a record in the zone changes, so it is usually left unset.",
+			Optional:    true,
+			Computed:    true,
+		},
+	},
+}
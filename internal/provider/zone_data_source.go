@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-netdot/internal/netdot"
+	"terraform-provider-netdot/internal/netdot/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &zoneDataSource{}
+)
+
+func NewZoneDataSource() datasource.DataSource {
+	return &zoneDataSource{}
+}
+
+type zoneDataSource struct {
+	client *netdot.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *zoneDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netdot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *zoneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = zoneDataSourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state zoneModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError("ID or Name is required", "ID or Name must be provided")
+		return
+	}
+
+	if !state.ID.IsNull() && !state.Name.IsNull() {
+		resp.Diagnostics.AddError("ID and Name are mutually exclusive", "Only one of ID or Name can be provided")
+		return
+	}
+
+	var netdotZone models.Zone
+
+	if !state.ID.IsNull() {
+		_, err := d.client.GetResourceByID("zone", state.ID.ValueInt64(), &netdotZone)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading zone", err.Error())
+			return
+		}
+	} else {
+		zone, err := d.client.GetZoneByName(state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading zone", err.Error())
+			return
+		}
+		netdotZone = zone
+	}
+
+	// keep the name as written, netdot matches it case-insensitively
+	configuredName := state.Name
+	state = ZoneToZoneModel(netdotZone)
+	if !configuredName.IsNull() {
+		state.Name = configuredName
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}